@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// apiCallLogEntry is a single line of the AWS API call log.
+type apiCallLogEntry struct {
+	Time         time.Time `json:"time"`
+	Service      string    `json:"service"`
+	Operation    string    `json:"operation"`
+	Region       string    `json:"region,omitempty"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	DurationMS   int64     `json:"duration_ms"`
+	RetryCount   int       `json:"retry_count"`
+	RequestID    string    `json:"request_id,omitempty"`
+	Success      bool      `json:"success"`
+	ErrorCode    string    `json:"error_code,omitempty"`
+}
+
+// apiCallLogger writes one JSON line per AWS API call.
+type apiCallLogger struct {
+	mutex  sync.Mutex
+	now    func() time.Time
+	writer io.Writer
+}
+
+func newAPICallLogger(w io.Writer) *apiCallLogger {
+	return &apiCallLogger{
+		now:    time.Now,
+		writer: w,
+	}
+}
+
+// addMiddleware adds the API call logging middleware to an AWS SDK for Go v2 API client's middleware stack.
+// The middleware runs before the retry middleware so that each API call is logged once, with its retry count.
+func (l *apiCallLogger) addMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformAPICallLog", l.handleInitialize), middleware.After)
+}
+
+func (l *apiCallLogger) handleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	start := l.now()
+
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	entry := apiCallLogEntry{
+		Time:       start.UTC(),
+		Service:    awsmiddleware.GetServiceID(ctx),
+		Operation:  awsmiddleware.GetOperationName(ctx),
+		Region:     awsmiddleware.GetRegion(ctx),
+		DurationMS: l.now().Sub(start).Milliseconds(),
+		Success:    err == nil,
+	}
+
+	if inContext, ok := FromContext(ctx); ok {
+		entry.ResourceType = inContext.TypeName()
+	}
+
+	if v, ok := retry.GetAttemptResults(metadata); ok {
+		entry.RetryCount = max(len(v.Results)-1, 0)
+	}
+
+	if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		entry.RequestID = v
+	}
+
+	if apiErr, ok := errs.As[smithy.APIError](err); ok {
+		entry.ErrorCode = apiErr.ErrorCode()
+	}
+
+	l.write(ctx, entry)
+
+	return out, metadata, err
+}
+
+func (l *apiCallLogger) write(ctx context.Context, entry apiCallLogEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		tflog.Warn(ctx, "encoding AWS API call log entry", map[string]any{
+			"error": err.Error(),
+		})
+		return
+	}
+	line = append(line, '\n')
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if _, err := l.writer.Write(line); err != nil {
+		tflog.Warn(ctx, "writing AWS API call log entry", map[string]any{
+			"error": err.Error(),
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAPICallLoggerMiddleware(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		ctx       func(context.Context) context.Context
+		errs      []error
+		wantEntry apiCallLogEntry
+	}{
+		{
+			name: "success",
+			ctx: func(ctx context.Context) context.Context {
+				return NewResourceContext(ctx, "iam", "Role", "aws_iam_role", "")
+			},
+			errs: []error{nil},
+			wantEntry: apiCallLogEntry{
+				Time:         start,
+				Service:      "IAM",
+				Operation:    "CreateRole",
+				Region:       "us-east-1", //lintignore:AWSAT003
+				ResourceType: "aws_iam_role",
+				DurationMS:   1500,
+				Success:      true,
+			},
+		},
+		{
+			name: "retried",
+			errs: []error{&smithy.GenericAPIError{Code: "Throttling"}, nil},
+			wantEntry: apiCallLogEntry{
+				Time:       start,
+				Service:    "IAM",
+				Operation:  "CreateRole",
+				Region:     "us-east-1", //lintignore:AWSAT003
+				DurationMS: 1500,
+				RetryCount: 1,
+				Success:    true,
+			},
+		},
+		{
+			name: "error",
+			errs: []error{&smithy.GenericAPIError{Code: "EntityAlreadyExists"}},
+			wantEntry: apiCallLogEntry{
+				Time:       start,
+				Service:    "IAM",
+				Operation:  "CreateRole",
+				Region:     "us-east-1", //lintignore:AWSAT003
+				DurationMS: 1500,
+				ErrorCode:  "EntityAlreadyExists",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			logger := newAPICallLogger(&buf)
+			now := start
+			logger.now = func() time.Time {
+				v := now
+				now = now.Add(1500 * time.Millisecond)
+				return v
+			}
+
+			stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				ServiceID:     "IAM",
+				OperationName: "CreateRole",
+				Region:        "us-east-1", //lintignore:AWSAT003
+			}, middleware.Before); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			retryer := retry.NewStandard(func(o *retry.StandardOptions) {
+				o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) { return 0, nil })
+			})
+			if err := stack.Finalize.Add(retry.NewAttemptMiddleware(retryer, smithyhttp.RequestCloner), middleware.After); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if err := logger.addMiddleware(stack); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var attempt int
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+				err := testCase.errs[attempt]
+				attempt++
+				return &smithyhttp.Response{}, middleware.Metadata{}, err
+			}), stack)
+
+			ctx := context.Background()
+			if testCase.ctx != nil {
+				ctx = testCase.ctx(ctx)
+			}
+
+			_, _, _ = handler.Handle(ctx, struct{}{})
+
+			var got apiCallLogEntry
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.wantEntry); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@
 package conns
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 )
@@ -27,3 +29,48 @@ func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
 	}
 	return r.RetryerV2.IsErrorRetryable(err)
 }
+
+// RetryConfig overrides the retry behavior of a service's AWS API client.
+type RetryConfig struct {
+	Service             string
+	MaxAttempts         int
+	MaxBackoff          time.Duration
+	RetryableErrorCodes []string
+}
+
+func newRetryConfigs(configs []RetryConfig) map[string]RetryConfig {
+	m := make(map[string]RetryConfig, len(configs))
+
+	for _, config := range configs {
+		m[config.Service] = config
+	}
+
+	return m
+}
+
+// retryer returns a function that returns the Retryer returned by base, wrapped with the overrides.
+// Service packages that add their own retryables wrap the Retryer returned by aws.Config.Retryer, so the overrides apply to all clients for the service.
+func (c RetryConfig) retryer(base func() aws.Retryer) func() aws.Retryer {
+	return func() aws.Retryer {
+		var r aws.Retryer
+		if base != nil {
+			r = base()
+		} else {
+			r = retry.NewStandard()
+		}
+
+		if c.MaxAttempts > 0 {
+			r = retry.AddWithMaxAttempts(r, c.MaxAttempts)
+		}
+
+		if c.MaxBackoff > 0 {
+			r = retry.AddWithMaxBackoffDelay(r, c.MaxBackoff)
+		}
+
+		if len(c.RetryableErrorCodes) > 0 {
+			r = retry.AddWithErrorCodes(r, c.RetryableErrorCodes...)
+		}
+
+		return r
+	}
+}
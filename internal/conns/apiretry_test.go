@@ -4,17 +4,21 @@
 package conns
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
 	appconfigtypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
 	smithy "github.com/aws/smithy-go"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAddIsErrorRetryables(t *testing.T) {
@@ -93,3 +97,137 @@ func TestAddIsErrorRetryables(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryConfigRetryer(t *testing.T) {
+	t.Parallel()
+
+	base := func() aws.Retryer {
+		return retry.NewStandard()
+	}
+	retryableErr := &smithy.GenericAPIError{Code: "ConcurrentModificationException"}
+
+	testCases := []struct {
+		name            string
+		config          RetryConfig
+		wantMaxAttempts int
+		wantMaxBackoff  time.Duration
+		wantIsRetryable bool
+	}{
+		{
+			name:            "no overrides",
+			wantMaxAttempts: retry.DefaultMaxAttempts,
+			wantMaxBackoff:  retry.DefaultMaxBackoff,
+		},
+		{
+			name: "overrides",
+			config: RetryConfig{
+				MaxAttempts:         10,
+				MaxBackoff:          5 * time.Second,
+				RetryableErrorCodes: []string{"ConcurrentModificationException"},
+			},
+			wantMaxAttempts: 10,
+			wantMaxBackoff:  5 * time.Second,
+			wantIsRetryable: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := testCase.config.retryer(base)()
+
+			// Service packages require an aws.RetryerV2.
+			v2, ok := r.(aws.RetryerV2)
+			if !ok {
+				t.Fatalf("retryer is not an aws.RetryerV2")
+			}
+
+			// Service packages' retryables are added to the overridden Retryer.
+			v2 = AddIsErrorRetryables(v2)
+
+			if got, want := v2.MaxAttempts(), testCase.wantMaxAttempts; got != want {
+				t.Errorf("MaxAttempts() = %d, want %d", got, want)
+			}
+
+			for attempt := range 20 {
+				delay, err := v2.RetryDelay(attempt, retryableErr)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if delay > testCase.wantMaxBackoff {
+					t.Errorf("RetryDelay(%d) = %s, want at most %s", attempt, delay, testCase.wantMaxBackoff)
+				}
+			}
+
+			if got, want := v2.IsErrorRetryable(retryableErr), testCase.wantIsRetryable; got != want {
+				t.Errorf("IsErrorRetryable(%q) = %v, want %v", retryableErr, got, want)
+			}
+		})
+	}
+}
+
+func TestAPIClientConfigRetryConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name            string
+		retryConfigs    []RetryConfig
+		wantMaxAttempts int
+	}{
+		{
+			name:            "no overrides",
+			wantMaxAttempts: 25,
+		},
+		{
+			name: "other service overrides",
+			retryConfigs: []RetryConfig{
+				{Service: names.S3, MaxAttempts: 5},
+			},
+			wantMaxAttempts: 25,
+		},
+		{
+			name: "max attempts override",
+			retryConfigs: []RetryConfig{
+				{Service: names.AppConfig, MaxAttempts: 5},
+			},
+			wantMaxAttempts: 5,
+		},
+		{
+			name: "max backoff override",
+			retryConfigs: []RetryConfig{
+				{Service: names.AppConfig, MaxBackoff: 5 * time.Second},
+			},
+			wantMaxAttempts: 25,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				awsConfig: &aws.Config{
+					Region:           "us-west-2", //lintignore:AWSAT003
+					RetryMaxAttempts: 25,
+					Retryer: func() aws.Retryer {
+						return retry.NewStandard()
+					},
+				},
+				partition:    standardPartition,
+				retryConfigs: newRetryConfigs(testCase.retryConfigs),
+			}
+
+			cfg := client.apiClientConfig(ctx, names.AppConfig)["aws_sdkv2_config"].(*aws.Config)
+
+			// The API client's constructor applies the configured RetryMaxAttempts to the Retryer.
+			conn := appconfig.NewFromConfig(*cfg)
+
+			if got, want := conn.Options().Retryer.MaxAttempts(), testCase.wantMaxAttempts; got != want {
+				t.Errorf("MaxAttempts() = %d, want %d", got, want)
+			}
+		})
+	}
+}
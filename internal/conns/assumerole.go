@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// AssumeRoleOverride represents a per-resource IAM role to assume.
+type AssumeRoleOverride struct {
+	ExternalID  string
+	RoleARN     string
+	SessionName string
+}
+
+// key returns a string that uniquely identifies the override.
+func (v AssumeRoleOverride) key() string {
+	return strings.Join([]string{v.RoleARN, v.SessionName, v.ExternalID}, "|")
+}
+
+// accountID returns the AWS account ID of the role to assume.
+func (v AssumeRoleOverride) accountID() string {
+	if arn, err := arn.Parse(v.RoleARN); err == nil {
+		return arn.AccountID
+	}
+
+	return ""
+}
+
+// overrideAssumeRole returns any per-resource assume role override in effect for the currently in-process operation.
+func overrideAssumeRole(ctx context.Context) (AssumeRoleOverride, bool) {
+	if inContext, ok := FromContext(ctx); ok {
+		if v := inContext.OverrideAssumeRole(); v != nil {
+			return *v, true
+		}
+	}
+
+	return AssumeRoleOverride{}, false
+}
+
+// assumeRoleConfig returns the AWS SDK for Go v2 configuration whose credentials are those of the specified role.
+// Configurations are cached per role so that the assumed role credentials are shared by all API clients.
+func (c *AWSClient) assumeRoleConfig(v AssumeRoleOverride) *aws.Config {
+	c.assumeRoleLock.Lock()
+	defer c.assumeRoleLock.Unlock()
+
+	key := v.key()
+	if cfg, ok := c.assumeRoleConfigs[key]; ok {
+		return cfg
+	}
+
+	cfg := c.awsConfig.Copy()
+	stsClient := sts.NewFromConfig(*c.awsConfig, func(o *sts.Options) {
+		if c.stsRegion != "" {
+			o.Region = c.stsRegion
+		}
+		if v := c.endpoints[names.STS]; v != "" {
+			o.BaseEndpoint = aws.String(v)
+		}
+	})
+	cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsClient, v.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+		if v.ExternalID != "" {
+			o.ExternalID = aws.String(v.ExternalID)
+		}
+		if v.SessionName != "" {
+			o.RoleSessionName = v.SessionName
+		}
+	}))
+
+	if c.assumeRoleConfigs == nil {
+		c.assumeRoleConfigs = make(map[string]*aws.Config)
+	}
+	c.assumeRoleConfigs[key] = &cfg
+
+	return &cfg
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+)
+
+func TestAWSClientAccountIDAssumeRoleOverride(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		overrideAssumeRole *AssumeRoleOverride
+		expected           string
+	}{
+		{
+			name:     "no override",
+			expected: "123456789012",
+		},
+		{
+			name: "override",
+			overrideAssumeRole: &AssumeRoleOverride{
+				RoleARN: "arn:aws:iam::210987654321:role/test", //lintignore:AWSAT005
+			},
+			expected: "210987654321",
+		},
+		{
+			name: "invalid role ARN",
+			overrideAssumeRole: &AssumeRoleOverride{
+				RoleARN: "test",
+			},
+			expected: "123456789012",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(context.Background(), "", "", "", "")
+			if v := testCase.overrideAssumeRole; v != nil {
+				ctx = WithOverrideAssumeRole(ctx, *v)
+			}
+			client := &AWSClient{
+				accountID: "123456789012",
+			}
+
+			if got, want := client.AccountID(ctx), testCase.expected; got != want {
+				t.Errorf("AccountID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientAssumeRoleConfig(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		awsConfig: &aws.Config{
+			Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+			Region:      "us-west-2", //lintignore:AWSAT003
+		},
+	}
+	v1 := AssumeRoleOverride{
+		RoleARN: "arn:aws:iam::210987654321:role/test", //lintignore:AWSAT005
+	}
+	v2 := AssumeRoleOverride{
+		ExternalID: "external",
+		RoleARN:    "arn:aws:iam::210987654321:role/test", //lintignore:AWSAT005
+	}
+
+	cfg1 := client.assumeRoleConfig(v1)
+	if cfg1 == client.awsConfig {
+		t.Fatal("assume role configuration is the provider's configuration")
+	}
+	if cfg1.Credentials == client.awsConfig.Credentials {
+		t.Error("assume role configuration uses the provider's credentials")
+	}
+	if got, want := cfg1.Region, client.awsConfig.Region; got != want {
+		t.Errorf("Region = %q, want %q", got, want)
+	}
+
+	if got := client.assumeRoleConfig(v1); got != cfg1 {
+		t.Error("assume role configuration not cached")
+	}
+	if got := client.assumeRoleConfig(v2); got == cfg1 {
+		t.Error("assume role configuration shared between different overrides")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"math/rand" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used -- Deterministic PRNG required for VCR test reproducibility
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -30,8 +32,11 @@ import (
 
 type AWSClient struct {
 	accountID                 string
+	assumeRoleConfigs         map[string]*aws.Config // Per-resource assume role override key -> AWS SDK for Go v2 configuration.
+	assumeRoleLock            sync.Mutex
 	awsConfig                 *aws.Config
-	clients                   map[string]map[string]any // Region -> service package name -> API client.
+	clients                   map[string]map[string]any   // Region and any assume role override key -> service package name -> API client.
+	concurrencyLimits         map[string]tfsync.Semaphore // Resource type name -> semaphore.
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
@@ -39,7 +44,10 @@ type AWSClient struct {
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
-	randomnessSource          rand.Source // For VCR deterministic randomness.
+	randomnessSource          rand.Source                     // For VCR deterministic randomness.
+	rateLimiters              map[string]*serviceRateLimiters // Service package name -> rate limiters.
+	readOnly                  bool                            // From provider configuration.
+	retryConfigs              map[string]RetryConfig          // Service package name -> retry overrides.
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
 	s3OriginalRegion          string // Original region for S3-compatible storage
@@ -78,7 +86,13 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
+// DefaultTagsConfig returns the provider's default tags configuration.
+// If the context carries resource information, any default tags rules are resolved for that resource.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if v, ok := FromContext(ctx); ok {
+		return c.defaultTagsConfig.ForResource(v.TypeName(), v.ServicePackageName())
+	}
+
 	return c.defaultTagsConfig
 }
 
@@ -90,12 +104,32 @@ func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.TagPolicyConfig {
 	return c.tagPolicyConfig
 }
 
+// ReadOnly returns whether the provider is in read-only mode, in which resources can't be created, updated or deleted.
+func (c *AWSClient) ReadOnly(context.Context) bool {
+	return c.readOnly
+}
+
+// ResourceConcurrencySemaphore returns the semaphore that limits concurrent Create, Update and Delete operations
+// for the specified resource type, if one is configured.
+func (c *AWSClient) ResourceConcurrencySemaphore(_ context.Context, typeName string) (tfsync.Semaphore, bool) {
+	v, ok := c.concurrencyLimits[typeName]
+	return v, ok
+}
+
 func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	return c.awsConfig.Copy()
 }
 
-// AccountID returns the configured AWS account ID.
-func (c *AWSClient) AccountID(context.Context) string {
+// AccountID returns the ID of the effective AWS account.
+// If the currently in-process operation has defined a per-resource assume role override,
+// the role's account ID is returned, otherwise the configured account ID is returned.
+func (c *AWSClient) AccountID(ctx context.Context) string {
+	if v, ok := overrideAssumeRole(ctx); ok {
+		if accountID := v.accountID(); accountID != "" {
+			return accountID
+		}
+	}
+
 	return c.accountID
 }
 
@@ -353,8 +387,33 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	if awsConfig != nil {
+		if v, ok := overrideAssumeRole(ctx); ok {
+			awsConfig = c.assumeRoleConfig(v)
+		}
+
+		rateLimiters, hasRateLimiters := c.rateLimiters[servicePackageName]
+		retryConfig, hasRetryConfig := c.retryConfigs[servicePackageName]
+
+		if hasRateLimiters || hasRetryConfig {
+			cfg := awsConfig.Copy()
+			if hasRateLimiters {
+				cfg.APIOptions = append(slices.Clone(cfg.APIOptions), rateLimiters.addMiddleware)
+			}
+			if hasRetryConfig {
+				cfg.Retryer = retryConfig.retryer(cfg.Retryer)
+				// Each API client wraps its Retryer with RetryMaxAttempts, which would otherwise undo the override.
+				if retryConfig.MaxAttempts > 0 {
+					cfg.RetryMaxAttempts = retryConfig.MaxAttempts
+				}
+			}
+			awsConfig = &cfg
+		}
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
@@ -384,6 +443,10 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 	region := c.Region(ctx)
+	key := region
+	if v, ok := overrideAssumeRole(ctx); ok {
+		key += "/" + v.key()
+	}
 
 	isDefault := len(extra) == 0
 	// Default service client is cached.
@@ -391,7 +454,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if v, ok := c.clients[region]; ok {
+		if v, ok := c.clients[key]; ok {
 			if raw, ok := v[servicePackageName]; ok {
 				if client, ok := raw.(T); ok {
 					return client, nil
@@ -425,10 +488,10 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		if _, ok := c.clients[region]; !ok {
-			c.clients[region] = make(map[string]any, 0)
+		if _, ok := c.clients[key]; !ok {
+			c.clients[key] = make(map[string]any, 0)
 		}
-		c.clients[region][servicePackageName] = client
+		c.clients[key][servicePackageName] = client
 	}
 
 	return client, nil
@@ -5,7 +5,9 @@ package conns
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tags/tagpolicy"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -30,8 +33,10 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APICallLog                     string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	ConcurrencyLimits              map[string]int
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -46,8 +51,11 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	RateLimits                     []RateLimitConfig
+	ReadOnly                       bool
 	Region                         string
 	RetryMode                      aws.RetryMode
+	Retries                        []RetryConfig
 	S3OriginalRegion               string
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
@@ -161,6 +169,20 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if c.APICallLog != "" {
+		// The log file remains open for the lifetime of the provider process.
+		f, err := os.OpenFile(c.APICallLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "opening API call log (%s): %s", c.APICallLog, err)
+		}
+		cfg.APIOptions = append(cfg.APIOptions, newAPICallLogger(f).addMiddleware)
+	}
+
+	if c.ReadOnly {
+		tflog.Info(ctx, "Read-only mode enabled, AWS API operations that modify resources are not permitted")
+		cfg.APIOptions = append(cfg.APIOptions, addReadOnlyMiddleware)
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -224,11 +246,33 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			return nil, diags
 		}
 		c.TagPolicyConfig.RequiredTags = reqTags
+
+		rules, err := tagpolicy.GetRules(ctx, cfg)
+		if errors.Is(err, tagpolicy.ErrEffectivePolicyAccessDenied) {
+			// Required tag enforcement does not depend on the effective policy, so skip rule enforcement only.
+			diags = append(diags, errs.NewWarningDiagnostic(
+				"Retrieving Effective Tag Policy",
+				`Tag key capitalization and allowed value rules from the effective tag policy are not enforced because `+
+					`the calling principal does not have the "organizations:DescribeEffectivePolicy" IAM permission.`+
+					fmt.Sprintf("\n\nOriginal error: %s", err)))
+		} else if err != nil {
+			diags = append(diags, errs.NewErrorDiagnostic(
+				"Retrieving Effective Tag Policy",
+				`Failed to retrieve the effective tag policy from AWS Organizations. Ensure the calling principal `+
+					`has the "organizations:DescribeEffectivePolicy" IAM permission.`+
+					fmt.Sprintf("\n\nOriginal error: %s", err)))
+			return nil, diags
+		}
+		c.TagPolicyConfig.Rules = rules
 	}
 
 	client.accountID = accountID
+	client.concurrencyLimits = newConcurrencyLimits(c.ConcurrencyLimits)
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.rateLimiters = newRateLimiters(c.RateLimits)
+	client.readOnly = c.ReadOnly
+	client.retryConfigs = newRetryConfigs(c.Retries)
 	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
 
@@ -245,6 +289,17 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	return client, diags
 }
 
+// newConcurrencyLimits returns a semaphore for each resource type with a concurrency limit.
+func newConcurrencyLimits(limits map[string]int) map[string]tfsync.Semaphore {
+	semaphores := make(map[string]tfsync.Semaphore, len(limits))
+
+	for typeName, limit := range limits {
+		semaphores[typeName] = tfsync.NewSemaphore(limit)
+	}
+
+	return semaphores
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
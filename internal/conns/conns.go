@@ -55,11 +55,17 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
-	overrideRegion     string // Any currently in effect per-resource Region override.
-	resourceName       string // Friendly resource name, e.g. "Subnet"
-	typeName           string // Resource type name, e.g. "aws_iam_role"
-	servicePackageName string // Canonical name defined as a constant in names package
-	vcrEnabled         bool   // Whether VCR testing is enabled
+	overrideAssumeRole *AssumeRoleOverride // Any currently in effect per-resource assume role override.
+	overrideRegion     string              // Any currently in effect per-resource Region override.
+	resourceName       string              // Friendly resource name, e.g. "Subnet"
+	typeName           string              // Resource type name, e.g. "aws_iam_role"
+	servicePackageName string              // Canonical name defined as a constant in names package
+	vcrEnabled         bool                // Whether VCR testing is enabled
+}
+
+// OverrideAssumeRole returns any currently in effect per-resource assume role override.
+func (c *InContext) OverrideAssumeRole() *AssumeRoleOverride {
+	return c.overrideAssumeRole
 }
 
 // OverrideRegion returns any currently in effect per-resource Region override.
@@ -99,6 +105,20 @@ func NewResourceContext(ctx context.Context, servicePackageName, resourceName, t
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+// WithOverrideAssumeRole returns a copy of the parent Context with the specified per-resource assume role override in effect.
+// The parent Context must have been created by NewResourceContext.
+func WithOverrideAssumeRole(ctx context.Context, overrideAssumeRole AssumeRoleOverride) context.Context {
+	inContext, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	v := *inContext
+	v.overrideAssumeRole = &overrideAssumeRole
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
 func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
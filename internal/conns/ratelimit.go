@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// Throttled requests reduce the request rate multiplicatively, to no less than a fraction of the configured rate.
+	rateLimitDecreaseFactor = 0.5
+	rateLimitMinimumFactor  = 0.05
+
+	// Successful requests restore the request rate additively, by a fraction of the configured rate.
+	rateLimitIncreaseFactor = 0.05
+)
+
+// RateLimitConfig configures client-side rate limiting of AWS API requests for a service or a single service operation.
+type RateLimitConfig struct {
+	Service           string
+	Operation         string
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// rateLimiter is a token bucket rate limiter whose rate adapts to throttling errors.
+type rateLimiter struct {
+	burst   float64
+	last    time.Time
+	maxRate float64
+	mutex   sync.Mutex
+	now     func() time.Time
+	rate    float64
+	tokens  float64
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if burst <= 0 {
+		burst = max(int(math.Ceil(requestsPerSecond)), 1)
+	}
+
+	return &rateLimiter{
+		burst:   float64(burst),
+		maxRate: requestsPerSecond,
+		now:     time.Now,
+		rate:    requestsPerSecond,
+		tokens:  float64(burst),
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = min(l.tokens+now.Sub(l.last).Seconds()*l.rate, l.burst)
+	}
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// wait blocks until a request is permitted or the Context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	d := l.reserve()
+	if d == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// throttled reduces the request rate after a throttling error.
+func (l *rateLimiter) throttled() float64 {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.rate = max(l.rate*rateLimitDecreaseFactor, l.maxRate*rateLimitMinimumFactor)
+
+	return l.rate
+}
+
+// succeeded restores the request rate after a successful request.
+func (l *rateLimiter) succeeded() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.rate = min(l.rate+l.maxRate*rateLimitIncreaseFactor, l.maxRate)
+}
+
+// serviceRateLimiters contains the rate limiters for a single service.
+type serviceRateLimiters struct {
+	service    *rateLimiter
+	operations map[string]*rateLimiter
+}
+
+func newRateLimiters(configs []RateLimitConfig) map[string]*serviceRateLimiters {
+	limiters := make(map[string]*serviceRateLimiters)
+
+	for _, config := range configs {
+		v, ok := limiters[config.Service]
+		if !ok {
+			v = &serviceRateLimiters{
+				operations: make(map[string]*rateLimiter),
+			}
+			limiters[config.Service] = v
+		}
+
+		limiter := newRateLimiter(config.RequestsPerSecond, config.Burst)
+		if config.Operation == "" {
+			v.service = limiter
+		} else {
+			v.operations[config.Operation] = limiter
+		}
+	}
+
+	return limiters
+}
+
+// addMiddleware adds the rate limiting middleware to an AWS SDK for Go v2 API client's middleware stack.
+// The middleware runs after the retry middleware so that every attempt is rate limited.
+func (l *serviceRateLimiters) addMiddleware(stack *middleware.Stack) error {
+	return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("TerraformRateLimit", l.handleFinalize), middleware.After)
+}
+
+func (l *serviceRateLimiters) handleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	operation := awsmiddleware.GetOperationName(ctx)
+
+	var limiters []*rateLimiter
+	if v, ok := l.operations[operation]; ok {
+		limiters = append(limiters, v)
+	}
+	if l.service != nil {
+		limiters = append(limiters, l.service)
+	}
+
+	for _, limiter := range limiters {
+		if err := limiter.wait(ctx); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+	}
+
+	out, metadata, err := next.HandleFinalize(ctx, in)
+
+	if err != nil && retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool() {
+		for _, limiter := range limiters {
+			rate := limiter.throttled()
+			tflog.Debug(ctx, "reducing client-side AWS API request rate after throttling", map[string]any{
+				"operation":           operation,
+				"requests_per_second": rate,
+			})
+		}
+	} else if err == nil {
+		for _, limiter := range limiters {
+			limiter.succeeded()
+		}
+	}
+
+	return out, metadata, err
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	limiter := newRateLimiter(2, 0)
+	limiter.now = func() time.Time { return now }
+
+	// Burst defaults to the rate.
+	for range 2 {
+		if got, want := limiter.reserve(), time.Duration(0); got != want {
+			t.Fatalf("reserve() = %s, want %s", got, want)
+		}
+	}
+
+	if got, want := limiter.reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("reserve() = %s, want %s", got, want)
+	}
+
+	now = now.Add(2 * time.Second)
+
+	if got, want := limiter.reserve(), time.Duration(0); got != want {
+		t.Errorf("reserve() = %s, want %s", got, want)
+	}
+}
+
+func TestRateLimiterAdaptive(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRateLimiter(10, 1)
+
+	if got, want := limiter.throttled(), 5.0; got != want {
+		t.Errorf("throttled() = %v, want %v", got, want)
+	}
+
+	for range 10 {
+		limiter.throttled()
+	}
+
+	if got, want := limiter.rate, 0.5; got != want {
+		t.Errorf("rate = %v, want %v", got, want)
+	}
+
+	for range 100 {
+		limiter.succeeded()
+	}
+
+	if got, want := limiter.rate, 10.0; got != want {
+		t.Errorf("rate = %v, want %v", got, want)
+	}
+}
+
+func TestServiceRateLimitersMiddleware(t *testing.T) {
+	t.Parallel()
+
+	limiters := newRateLimiters([]RateLimitConfig{
+		{
+			Service:           "route53",
+			RequestsPerSecond: 100,
+		},
+		{
+			Service:           "route53",
+			Operation:         "ChangeResourceRecordSets",
+			RequestsPerSecond: 10,
+		},
+	})["route53"]
+
+	testCases := []struct {
+		name              string
+		operation         string
+		err               error
+		wantServiceRate   float64
+		wantOperationRate float64
+	}{
+		{
+			name:              "success",
+			operation:         "ChangeResourceRecordSets",
+			wantServiceRate:   100,
+			wantOperationRate: 10,
+		},
+		{
+			name:              "other operation throttled",
+			operation:         "ListResourceRecordSets",
+			err:               &smithy.GenericAPIError{Code: "Throttling"},
+			wantServiceRate:   50,
+			wantOperationRate: 10,
+		},
+		{
+			name:              "operation throttled",
+			operation:         "ChangeResourceRecordSets",
+			err:               &smithy.GenericAPIError{Code: "ThrottlingException"},
+			wantServiceRate:   25,
+			wantOperationRate: 5,
+		},
+		{
+			name:              "not throttled",
+			operation:         "ChangeResourceRecordSets",
+			err:               &smithy.GenericAPIError{Code: "InvalidInput"},
+			wantServiceRate:   25,
+			wantOperationRate: 5,
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:paralleltest // Cases share rate limiters.
+		t.Run(testCase.name, func(t *testing.T) {
+			stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{OperationName: testCase.operation}, middleware.Before); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if err := limiters.addMiddleware(stack); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+				return nil, middleware.Metadata{}, testCase.err
+			}), stack)
+
+			_, _, err := handler.Handle(context.Background(), struct{}{})
+
+			if !errors.Is(err, testCase.err) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := limiters.service.rate, testCase.wantServiceRate; got != want {
+				t.Errorf("service rate = %v, want %v", got, want)
+			}
+
+			if got, want := limiters.operations["ChangeResourceRecordSets"].rate, testCase.wantOperationRate; got != want {
+				t.Errorf("operation rate = %v, want %v", got, want)
+			}
+		})
+	}
+}
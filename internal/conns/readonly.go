@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	// ReadOnlyEnvVar enables read-only mode.
+	ReadOnlyEnvVar = "TF_AWS_READ_ONLY"
+)
+
+// readOnlyOperationPrefixes are prefixes of AWS API operation names that don't modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Download",
+	"Estimate",
+	"Filter",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+	"Simulate",
+	"Validate",
+}
+
+// readOnlyOperations are AWS API operations that don't modify resources but whose names don't have a read-only prefix.
+// Keyed by service ID.
+var readOnlyOperations = map[string][]string{
+	"EventBridge": {
+		"TestEventPattern",
+	},
+	"KMS": {
+		"Decrypt",
+		"Encrypt",
+		"GenerateDataKey",
+		"GenerateDataKeyPair",
+		"GenerateDataKeyPairWithoutPlaintext",
+		"GenerateDataKeyWithoutPlaintext",
+		"GenerateMac",
+		"GenerateRandom",
+		"Sign",
+		"Verify",
+		"VerifyMac",
+	},
+	"Route 53": {
+		"TestDNSAnswer",
+	},
+	"STS": {
+		"AssumeRole",
+		"AssumeRoleWithSAML",
+		"AssumeRoleWithWebIdentity",
+		"DecodeAuthorizationMessage",
+	},
+}
+
+// isReadOnlyOperation returns whether the specified AWS API operation doesn't modify resources.
+func isReadOnlyOperation(serviceID, operation string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+
+	return slices.Contains(readOnlyOperations[serviceID], operation)
+}
+
+// readOnlyOperationError is returned when an AWS API operation that modifies resources is called in read-only mode.
+type readOnlyOperationError struct {
+	ServiceID string
+	Operation string
+}
+
+func (e *readOnlyOperationError) Error() string {
+	return fmt.Sprintf("AWS API operation %s %s is not permitted as the provider is in read-only mode", e.ServiceID, e.Operation)
+}
+
+// addReadOnlyMiddleware adds the read-only mode middleware to an AWS SDK for Go v2 API client's middleware stack.
+func addReadOnlyMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformReadOnly", handleReadOnlyInitialize), middleware.After)
+}
+
+func handleReadOnlyInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	serviceID, operation := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)
+
+	if !isReadOnlyOperation(serviceID, operation) {
+		return middleware.InitializeOutput{}, middleware.Metadata{}, &readOnlyOperationError{
+			ServiceID: serviceID,
+			Operation: operation,
+		}
+	}
+
+	return next.HandleInitialize(ctx, in)
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		serviceID string
+		operation string
+		expected  bool
+	}{
+		{serviceID: "EC2", operation: "DescribeInstances", expected: true},
+		{serviceID: "IAM", operation: "GetRole", expected: true},
+		{serviceID: "S3", operation: "ListObjectsV2", expected: true},
+		{serviceID: "S3", operation: "HeadObject", expected: true},
+		{serviceID: "DynamoDB", operation: "BatchGetItem", expected: true},
+		{serviceID: "KMS", operation: "Decrypt", expected: true},
+		{serviceID: "KMS", operation: "Verify", expected: true},
+		{serviceID: "KMS", operation: "VerifyMac", expected: true},
+		{serviceID: "STS", operation: "AssumeRole", expected: true},
+		{serviceID: "EC2", operation: "RunInstances"},
+		{serviceID: "EC2", operation: "CreateTags"},
+		{serviceID: "IAM", operation: "PutRolePolicy"},
+		{serviceID: "IAM", operation: "TagRole"},
+		{serviceID: "Lambda", operation: "Invoke"},
+		{serviceID: "SQS", operation: "ReceiveMessage"},
+		{serviceID: "S3", operation: "Decrypt"},
+		{serviceID: "RDS", operation: "ModifyDBInstance"},
+		{serviceID: "SES", operation: "VerifyDomainDkim"},
+		{serviceID: "SES", operation: "VerifyDomainIdentity"},
+		{serviceID: "SES", operation: "VerifyEmailAddress"},
+		{serviceID: "SES", operation: "VerifyEmailIdentity"},
+		{serviceID: "Cognito Identity Provider", operation: "VerifySoftwareToken"},
+		{serviceID: "Cognito Identity Provider", operation: "VerifyUserAttribute"},
+		{serviceID: "S3", operation: "Verify"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.serviceID+" "+testCase.operation, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isReadOnlyOperation(testCase.serviceID, testCase.operation), testCase.expected; got != want {
+				t.Errorf("isReadOnlyOperation(%q, %q) = %v, want %v", testCase.serviceID, testCase.operation, got, want)
+			}
+		})
+	}
+}
+
+func TestReadOnlyMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		operation   string
+		expectError bool
+	}{
+		{operation: "GetRole"},
+		{operation: "CreateRole", expectError: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.operation, func(t *testing.T) {
+			t.Parallel()
+
+			stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{ServiceID: "IAM", OperationName: testCase.operation}, middleware.Before); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if err := addReadOnlyMiddleware(stack); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var called bool
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+				called = true
+				return nil, middleware.Metadata{}, nil
+			}), stack)
+
+			_, _, err := handler.Handle(context.Background(), struct{}{})
+
+			if testCase.expectError {
+				if _, ok := errs.As[*readOnlyOperationError](err); !ok {
+					t.Errorf("expected read-only operation error, got %v", err)
+				}
+				if called {
+					t.Error("expected request to not be sent")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				if !called {
+					t.Error("expected request to be sent")
+				}
+			}
+		})
+	}
+}
@@ -96,6 +96,22 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to limit what resource sweepers delete
+const (
+	// Set to any non-empty value to log the resources that would be deleted without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated list of name prefixes. Only resources whose name, or ID if the name is unknown,
+	// begins with one of the prefixes are deleted
+	SweepNamePrefixes = "TF_AWS_SWEEP_NAME_PREFIXES"
+
+	// Maximum number of independent sweepers run concurrently. Defaults to 8
+	SweepParallelism = "TF_AWS_SWEEP_PARALLELISM"
+
+	// Comma-separated list of key=value tag selectors. Only resources with all of the tags are deleted
+	SweepTags = "TF_AWS_SWEEP_TAGS"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -4,9 +4,12 @@
 package depgraph
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/stack"
@@ -33,6 +36,11 @@ func (g *Graph) Len() int {
 	return len(g.nodes)
 }
 
+// Nodes returns the nodes in the graph, in the order they were added.
+func (g *Graph) Nodes() []string {
+	return slices.Clone(g.nodes)
+}
+
 // AddNode adds the specified string to the graph.
 func (g *Graph) AddNode(s string) {
 	if !g.HasNode(s) {
@@ -186,6 +194,79 @@ func (g *Graph) OverallOrder() ([]string, error) {
 	return order, nil
 }
 
+// Walk calls f for every node in the graph once all of the node's dependencies have been visited.
+// Independent nodes are visited concurrently, with at most parallelism calls to f in flight.
+// Nodes that depend (transitively) on a node for which f returns an error are not visited.
+// Returns an error if a dependency cycle is detected, otherwise the errors returned by f.
+func (g *Graph) Walk(ctx context.Context, parallelism int, f func(context.Context, string) error) error {
+	if _, err := g.OverallOrder(); err != nil {
+		return err
+	}
+
+	parallelism = max(parallelism, 1)
+
+	type visit struct {
+		done chan struct{}
+		ok   bool
+	}
+
+	visits := make(map[string]*visit, len(g.nodes))
+	for _, node := range g.nodes {
+		visits[node] = &visit{done: make(chan struct{})}
+	}
+
+	var (
+		errs  []error
+		mutex sync.Mutex
+		sem   = make(chan struct{}, parallelism)
+		wg    sync.WaitGroup
+	)
+
+	for _, node := range g.nodes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			v := visits[node]
+			defer close(v.done)
+
+			for _, dependency := range g.outgoingEdges[node] {
+				d := visits[dependency]
+				<-d.done
+
+				if !d.ok {
+					return
+				}
+			}
+
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+
+			if err := f(ctx, node); err != nil {
+				mutex.Lock()
+				errs = append(errs, err)
+				mutex.Unlock()
+
+				return
+			}
+
+			v.ok = true
+		}()
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
 // depthFirstSearch returns a Topological Sort using Depth-First-Search on a set of edges.
 // Returns an error if a dependency cycle is detected.
 func depthFirstSearch(edges map[string][]string) func(s string) ([]string, error) {
@@ -4,7 +4,12 @@
 package depgraph
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"reflect"
+	"slices"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -417,3 +422,105 @@ func TestDependencyGraphOverallOrder(t *testing.T) {
 		t.Fatalf("incorrect overall order. Expected: %v, got: %v", expected, got)
 	}
 }
+
+func TestDependencyGraphWalk(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	g := New()
+
+	g.AddNode("a")
+	g.AddNode("b")
+	g.AddNode("c")
+	g.AddNode("d")
+	g.AddNode("e")
+
+	err := g.AddDependency("a", "b")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = g.AddDependency("a", "c")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = g.AddDependency("b", "d")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = g.AddDependency("c", "d")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var (
+		mutex   sync.Mutex
+		visited []string
+	)
+
+	err = g.Walk(ctx, 2, func(_ context.Context, s string) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		dependencies, err := g.DirectDependenciesOf(s)
+		if err != nil {
+			return err
+		}
+
+		for _, dependency := range dependencies {
+			if !slices.Contains(visited, dependency) {
+				return fmt.Errorf("%s visited before dependency %s", s, dependency)
+			}
+		}
+
+		visited = append(visited, s)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	slices.Sort(visited)
+	if expected := []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(visited, expected) {
+		t.Fatalf("incorrect visited nodes. Expected: %v, got: %v", expected, visited)
+	}
+
+	// Dependents of a failed node are not visited.
+	visited = nil
+
+	err = g.Walk(ctx, 2, func(_ context.Context, s string) error {
+		if s == "c" {
+			return errors.New("failed")
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		visited = append(visited, s)
+
+		return nil
+	})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+
+	slices.Sort(visited)
+	if expected := []string{"b", "d", "e"}; !reflect.DeepEqual(visited, expected) {
+		t.Fatalf("incorrect visited nodes. Expected: %v, got: %v", expected, visited)
+	}
+
+	// Cycles are detected before any node is visited.
+	err = g.AddDependency("d", "a")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = g.Walk(ctx, 2, func(context.Context, string) error {
+		t.Fatal("unexpected visit")
+
+		return nil
+	})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -84,8 +85,9 @@ func (cidrBlockType) ValueType(context.Context) attr.Value {
 }
 
 var (
-	_ basetypes.StringValuable    = (*CIDRBlock)(nil)
-	_ xattr.ValidateableAttribute = (*CIDRBlock)(nil)
+	_ basetypes.StringValuable       = (*CIDRBlock)(nil)
+	_ xattr.ValidateableAttribute    = (*CIDRBlock)(nil)
+	_ function.ValidateableParameter = (*CIDRBlock)(nil)
 )
 
 func CIDRBlockNull() CIDRBlock {
@@ -133,3 +135,13 @@ func (v CIDRBlock) ValidateAttribute(ctx context.Context, req xattr.ValidateAttr
 		)
 	}
 }
+
+func (v CIDRBlock) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if err := inttypes.ValidateCIDRBlock(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(req.Position, "Invalid CIDR Block Value: "+err.Error())
+	}
+}
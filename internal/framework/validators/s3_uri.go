@@ -6,9 +6,9 @@ package validators
 import (
 	"context"
 
-	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/s3uri"
 )
 
 // s3URIValidator validates that a string Attribute's value is a valid S3 URI.
@@ -27,7 +27,8 @@ func (validator s3URIValidator) ValidateString(ctx context.Context, request vali
 		return
 	}
 
-	if !regexache.MustCompile(`^s3://[a-z0-9][\.\-a-z0-9]{1,61}[a-z0-9](/.*)?$`).MatchString(request.ConfigValue.ValueString()) {
+	// Only bucket URIs are accepted, not access point URIs.
+	if location, err := s3uri.Parse(request.ConfigValue.ValueString()); err != nil || location.AccessPoint != "" {
 		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
 			request.Path,
 			validator.Description(ctx),
@@ -52,6 +52,16 @@ func TestS3URIValidator(t *testing.T) {
 				),
 			},
 		},
+		"access point URI": {
+			val: types.StringValue("s3://arn:aws:s3:us-west-2:123456789012:accesspoint/example/key"), //lintignore:AWSAT003,AWSAT005
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must be a valid S3 URI, got: s3://arn:aws:s3:us-west-2:123456789012:accesspoint/example/key`, //lintignore:AWSAT003,AWSAT005
+				),
+			},
+		},
 	}
 
 	for name, test := range tests {
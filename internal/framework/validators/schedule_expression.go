@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+// scheduleExpressionValidator validates that a string Attribute's value is a valid AWS schedule expression.
+type scheduleExpressionValidator struct{}
+
+func (validator scheduleExpressionValidator) Description(_ context.Context) string {
+	return "value must be a valid cron(), rate() or at() schedule expression"
+}
+
+func (validator scheduleExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator scheduleExpressionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := schedule.Parse(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Attribute Value",
+			"Attribute "+request.Path.String()+" "+validator.Description(ctx)+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ScheduleExpression returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid AWS schedule expression
+//     (cron(minutes hours day-of-month month day-of-week year), rate(value unit) or at(yyyy-mm-ddThh:mm:ss)).
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func ScheduleExpression() validator.String {
+	return scheduleExpressionValidator{}
+}
@@ -0,0 +1,20 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// WithAssumeRoleModel is intended to be embedded in the models of resources which support the per-resource "assume_role" override.
+type WithAssumeRoleModel struct {
+	AssumeRole fwtypes.ListNestedObjectValueOf[AssumeRoleModel] `tfsdk:"assume_role"`
+}
+
+type AssumeRoleModel struct {
+	ExternalID  types.String `tfsdk:"external_id"`
+	RoleARN     fwtypes.ARN  `tfsdk:"role_arn"`
+	SessionName types.String `tfsdk:"session_name"`
+}
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// iamPolicyMergeStrategyAppend retains every statement. Exact duplicates are dropped and
+	// the Sid is removed from later statements that reuse an earlier statement's Sid.
+	iamPolicyMergeStrategyAppend = "append"
+	// iamPolicyMergeStrategyErrorOnConflict fails if two different statements share a Sid.
+	iamPolicyMergeStrategyErrorOnConflict = "error_on_conflict"
+	// iamPolicyMergeStrategyOverride replaces earlier statements with later statements of the same Sid.
+	iamPolicyMergeStrategyOverride = "override"
+)
+
+func iamPolicyMergeStrategy_Values() []string {
+	return []string{
+		iamPolicyMergeStrategyAppend,
+		iamPolicyMergeStrategyErrorOnConflict,
+		iamPolicyMergeStrategyOverride,
+	}
+}
+
+const (
+	defaultIAMPolicyVersion = "2012-10-17"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges multiple IAM policy documents into a single canonical policy document. " +
+			"Statements are matched by Sid and conflicts are resolved according to the merge strategy.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "documents",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IAM policy documents in JSON format, in order of increasing precedence",
+			},
+			function.StringParameter{
+				Name:                "strategy",
+				MarkdownDescription: "How statements with the same Sid are merged. One of `append`, `error_on_conflict` or `override`",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf(iamPolicyMergeStrategy_Values()...),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var documents []string
+	var strategy string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &documents, &strategy))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergeIAMPolicies(documents, strategy)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// mergeIAMPolicies merges IAM policy documents using the specified strategy and returns
+// the canonical JSON representation of the result.
+// Later documents take precedence for the Version and Id elements.
+func mergeIAMPolicies(documents []string, strategy string) (string, error) {
+	merged := map[string]any{
+		"Version": defaultIAMPolicyVersion,
+	}
+	var statements []any
+	sids := make(map[string]int)
+
+	for i, document := range documents {
+		doc, err := decodeIAMPolicy(document)
+		if err != nil {
+			return "", fmt.Errorf("documents[%d]: %w", i, err)
+		}
+
+		for _, k := range []string{"Version", "Id"} {
+			if v, ok := doc[k]; ok && v != "" {
+				merged[k] = v
+			}
+		}
+
+		v, _ := doc["Statement"].([]any)
+		for _, statement := range v {
+			sid, _ := statement.(map[string]any)["Sid"].(string)
+
+			j, ok := sids[sid]
+			if sid == "" || !ok {
+				if sid != "" {
+					sids[sid] = len(statements)
+				}
+				statements = append(statements, statement)
+				continue
+			}
+
+			switch strategy {
+			case iamPolicyMergeStrategyAppend:
+				equal, err := iamPolicyStatementsEqual(statements[j], statement)
+				if err != nil {
+					return "", err
+				}
+				if equal {
+					continue
+				}
+				// Sids must be unique within a policy.
+				delete(statement.(map[string]any), "Sid")
+				statements = append(statements, statement)
+			case iamPolicyMergeStrategyErrorOnConflict:
+				equal, err := iamPolicyStatementsEqual(statements[j], statement)
+				if err != nil {
+					return "", err
+				}
+				if !equal {
+					return "", fmt.Errorf("documents[%d]: conflicting statements with Sid %q", i, sid)
+				}
+			case iamPolicyMergeStrategyOverride:
+				statements[j] = statement
+			default:
+				return "", fmt.Errorf("unsupported merge strategy: %s", strategy)
+			}
+		}
+	}
+
+	// Drop statements which are exact duplicates of an earlier statement.
+	var encoded []string
+	statements = slices.DeleteFunc(statements, func(v any) bool {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return false
+		}
+		if slices.Contains(encoded, string(b)) {
+			return true
+		}
+		encoded = append(encoded, string(b))
+		return false
+	})
+
+	if statements == nil {
+		statements = []any{}
+	}
+	merged["Statement"] = statements
+
+	return encodeIAMPolicy(merged)
+}
+
+func iamPolicyStatementsEqual(a, b any) (bool, error) {
+	x, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+
+	y, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+
+	return string(x) == string(y), nil
+}
@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+const (
+	testIAMPolicyMergeSource   = `{"Version":"2012-10-17","Statement":[{"Sid":"S3","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"sqs:*","Resource":"*"}]}`
+	testIAMPolicyMergeOverride = `{"Statement":{"Sid":"S3","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}}`
+)
+
+func TestIAMPolicyMergeFunction_append(t *testing.T) {
+	t.Parallel()
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"S3"},{"Action":"s3:GetObject","Effect":"Deny","Resource":"*"},{"Action":"sqs:*","Effect":"Allow","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig("append", testIAMPolicyMergeSource, testIAMPolicyMergeOverride),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_appendDuplicateSid(t *testing.T) {
+	t.Parallel()
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"S3"},{"Action":"s3:GetObject","Effect":"Deny","Resource":"*"},{"Action":"sqs:*","Effect":"Allow","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// The repeated source statement is dropped and the conflicting statement loses its Sid.
+				Config: testIAMPolicyMergeFunctionConfig("append", testIAMPolicyMergeSource, testIAMPolicyMergeOverride, testIAMPolicyMergeSource, testIAMPolicyMergeOverride),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_override(t *testing.T) {
+	t.Parallel()
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Deny","Resource":"*","Sid":"S3"},{"Action":"sqs:*","Effect":"Allow","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig("override", testIAMPolicyMergeSource, testIAMPolicyMergeOverride),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_errorOnConflict(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig("error_on_conflict", testIAMPolicyMergeSource, testIAMPolicyMergeOverride),
+				ExpectError: regexache.MustCompile(`conflicting[\s\n]*statements[\s\n]*with[\s\n]*Sid`),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_invalidStrategy(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig("invalid", testIAMPolicyMergeSource),
+				ExpectError: regexache.MustCompile(`value[\s\n]*must[\s\n]*be[\s\n]*one[\s\n]*of`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyMergeFunctionConfig(strategy string, documents ...string) string {
+	var args string
+	for _, document := range documents {
+		args += fmt.Sprintf("%q, ", document)
+	}
+
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_merge([%[1]s], %[2]q)
+}
+`, args, strategy)
+}
@@ -0,0 +1,330 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = iamPolicyNormalizeFunction{}
+
+func NewIAMPolicyNormalizeFunction() function.Function {
+	return &iamPolicyNormalizeFunction{}
+}
+
+type iamPolicyNormalizeFunction struct{}
+
+func (f iamPolicyNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_normalize"
+}
+
+func (f iamPolicyNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_normalize Function",
+		MarkdownDescription: "Normalizes an IAM policy document into a canonical JSON representation. " +
+			"Statements and string sets are sorted, root user principals are rewritten as account IDs and " +
+			"single-element arrays are collapsed so that semantically equivalent policies produce identical output.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "json",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := normalizeIAMPolicy(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// normalizeIAMPolicy returns the canonical JSON representation of an IAM policy document.
+// The normalization mirrors the rules used by verify.PolicyStringsEquivalent so that
+// two documents considered equivalent by that function normalize to the same string.
+func normalizeIAMPolicy(s string) (string, error) {
+	doc, err := decodeIAMPolicy(s)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := encodeIAMPolicy(doc)
+	if err != nil {
+		return "", err
+	}
+
+	// Guard against the normalization rules drifting from the provider's own diff suppression.
+	if !verify.PolicyStringsEquivalent(s, result) {
+		return "", fmt.Errorf("normalized policy (%s) is not equivalent to the original policy", result)
+	}
+
+	return result, nil
+}
+
+// decodeIAMPolicy parses and normalizes an IAM policy document.
+// The document's Statement element, if present, is always a list of statements.
+func decodeIAMPolicy(s string) (map[string]any, error) {
+	var doc map[string]any
+
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("policy is invalid JSON: %w", err)
+	}
+	if dec.More() {
+		return nil, errors.New("policy is invalid JSON: unexpected data after top-level value")
+	}
+	if doc == nil {
+		return nil, errors.New("policy must be a JSON object")
+	}
+
+	if v, ok := doc["Statement"]; ok {
+		statements, err := normalizeIAMPolicyStatements(v)
+		if err != nil {
+			return nil, err
+		}
+		doc["Statement"] = statements
+	}
+
+	return doc, nil
+}
+
+// encodeIAMPolicy serializes a decoded IAM policy document, keeping the Version element first.
+func encodeIAMPolicy(doc map[string]any) (string, error) {
+	if v, ok := doc["Statement"].([]any); ok {
+		if err := sortIAMPolicyStatements(v); err != nil {
+			return "", err
+		}
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return verify.LegacyPolicyNormalize(string(b))
+}
+
+func normalizeIAMPolicyStatements(v any) ([]any, error) {
+	var statements []any
+
+	switch v := v.(type) {
+	case map[string]any:
+		statements = []any{v}
+	case []any:
+		statements = v
+	default:
+		return nil, errors.New("policy Statement must be an object or a list of objects")
+	}
+
+	for i, v := range statements {
+		statement, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("policy Statement[%d] must be an object", i)
+		}
+
+		if err := normalizeIAMPolicyStatement(statement); err != nil {
+			return nil, fmt.Errorf("policy Statement[%d]: %w", i, err)
+		}
+	}
+
+	return statements, nil
+}
+
+func normalizeIAMPolicyStatement(statement map[string]any) error {
+	for k, v := range statement {
+		switch k {
+		case "Effect":
+			if v, ok := v.(string); ok {
+				switch {
+				case strings.EqualFold(v, "Allow"):
+					statement[k] = "Allow"
+				case strings.EqualFold(v, "Deny"):
+					statement[k] = "Deny"
+				}
+			}
+
+		case "Action", "NotAction", "Resource", "NotResource":
+			set, err := normalizeIAMPolicyStringSet(v)
+			if err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+			if set == nil {
+				delete(statement, k)
+			} else {
+				statement[k] = set
+			}
+
+		case "Principal", "NotPrincipal":
+			switch principals := v.(type) {
+			case string:
+				statement[k] = normalizeIAMPolicyPrincipal(principals)
+			case map[string]any:
+				for principalType, v := range principals {
+					set, err := normalizeIAMPolicyStringSet(v, normalizeIAMPolicyPrincipal)
+					if err != nil {
+						return fmt.Errorf("%s.%s: %w", k, principalType, err)
+					}
+					if set == nil {
+						delete(principals, principalType)
+					} else {
+						principals[principalType] = set
+					}
+				}
+			}
+
+		case "Condition":
+			operators, ok := v.(map[string]any)
+			if !ok {
+				return fmt.Errorf("%s must be an object", k)
+			}
+
+			for operator, v := range operators {
+				conditions, ok := v.(map[string]any)
+				if !ok {
+					return fmt.Errorf("%s.%s must be an object", k, operator)
+				}
+
+				for key, v := range conditions {
+					set, err := normalizeIAMPolicyStringSet(v)
+					if err != nil {
+						return fmt.Errorf("%s.%s.%s: %w", k, operator, key, err)
+					}
+					if set == nil {
+						set = []any{}
+					}
+					conditions[key] = set
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// normalizeIAMPolicyStringSet converts a string or list of scalar values into a sorted set
+// of strings, applying any additional normalization functions to each member.
+// Duplicates are retained, as verify.PolicyStringsEquivalent does not consider
+// ["a", "a"] and "a" equivalent. A single-element set is collapsed to a plain string and
+// an empty set is returned as nil.
+func normalizeIAMPolicyStringSet(v any, fns ...func(string) string) (any, error) {
+	var values []any
+
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case []any:
+		values = v
+	default:
+		values = []any{v}
+	}
+
+	set := make([]string, 0, len(values))
+	for _, v := range values {
+		var s string
+
+		switch v := v.(type) {
+		case string:
+			s = v
+		case bool:
+			s = strconv.FormatBool(v)
+		case json.Number:
+			// Format numbers in the same way as awspolicyequivalence, e.g. 1.0 as "1".
+			f, err := v.Float64()
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %s: %w", v, err)
+			}
+			s = strconv.FormatFloat(f, 'f', -1, 64)
+		default:
+			return nil, fmt.Errorf("unsupported value type %T", v)
+		}
+
+		for _, fn := range fns {
+			s = fn(s)
+		}
+
+		set = append(set, s)
+	}
+
+	slices.Sort(set)
+
+	switch len(set) {
+	case 0:
+		return nil, nil
+	case 1:
+		return set[0], nil
+	}
+
+	result := make([]any, len(set))
+	for i, s := range set {
+		result[i] = s
+	}
+
+	return result, nil
+}
+
+var accountIDRegex = regexache.MustCompile(`^[0-9]{12}$`)
+
+// normalizeIAMPolicyPrincipal rewrites a root user ARN principal as its account ID.
+// AWS converts account ID principals to root user ARNs, and verify.PolicyStringsEquivalent
+// treats the two forms, in any partition, as equivalent.
+func normalizeIAMPolicyPrincipal(s string) string {
+	if v, err := arn.Parse(s); err == nil && v.Service == "iam" && v.Resource == "root" && accountIDRegex.MatchString(v.AccountID) {
+		return v.AccountID
+	}
+
+	return s
+}
+
+// sortIAMPolicyStatements sorts statements by their serialized form.
+func sortIAMPolicyStatements(statements []any) error {
+	encoded := make([][]byte, len(statements))
+	for i, v := range statements {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		encoded[i] = b
+	}
+
+	indices := make([]int, len(statements))
+	for i := range indices {
+		indices[i] = i
+	}
+	slices.SortStableFunc(indices, func(a, b int) int {
+		return bytes.Compare(encoded[a], encoded[b])
+	})
+
+	sorted := make([]any, len(statements))
+	for i, j := range indices {
+		sorted[i] = statements[j]
+	}
+	copy(statements, sorted)
+
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/s3uri"
+)
+
+var _ function.Function = s3ARNToURIFunction{}
+
+func NewS3ARNToURIFunction() function.Function {
+	return &s3ARNToURIFunction{}
+}
+
+type s3ARNToURIFunction struct{}
+
+func (f s3ARNToURIFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_arn_to_uri"
+}
+
+func (f s3ARNToURIFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "s3_arn_to_uri Function",
+		MarkdownDescription: "Converts the Amazon Resource Name (ARN) of an S3 bucket, object, access point " +
+			"or access point object into an S3 URI.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to convert",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f s3ARNToURIFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	location, err := s3uri.ParseARN(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, location.URI()))
+}
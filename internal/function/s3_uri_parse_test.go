@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3URIParseFunction_bucket(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("s3://example-bucket/path/to/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", "example-bucket"),
+					resource.TestCheckOutput("key", "path/to/object.txt"),
+					resource.TestCheckOutput("access_point", ""),
+					resource.TestCheckOutput("object_lambda", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_accessPoint(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("s3://arn:aws:s3-object-lambda:us-west-2:123456789012:accesspoint/example/path/to/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", ""),
+					resource.TestCheckOutput("key", "path/to/object.txt"),
+					resource.TestCheckOutput("access_point", "example"),
+					resource.TestCheckOutput("object_lambda", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIParseFunctionConfig("https://example-bucket/key"),
+				ExpectError: regexache.MustCompile(`S3[\s\n]*URI[\s\n]*must[\s\n]*begin`),
+			},
+		},
+	})
+}
+
+func testS3URIParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  parsed = provider::aws::s3_uri_parse(%[1]q)
+}
+
+output "bucket" {
+  value = local.parsed.bucket
+}
+
+output "key" {
+  value = local.parsed.key
+}
+
+output "access_point" {
+  value = local.parsed.access_point
+}
+
+output "object_lambda" {
+  value = local.parsed.object_lambda
+}
+`, arg)
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/s3uri"
+)
+
+var _ function.Function = s3URIToARNFunction{}
+
+func NewS3URIToARNFunction() function.Function {
+	return &s3URIToARNFunction{}
+}
+
+type s3URIToARNFunction struct{}
+
+func (f s3URIToARNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_to_arn"
+}
+
+func (f s3URIToARNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "s3_uri_to_arn Function",
+		MarkdownDescription: "Converts an S3 URI into the Amazon Resource Name (ARN) of the bucket, object, access point " +
+			"or access point object it references.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "S3 URI to convert",
+			},
+			function.StringParameter{
+				Name:                "partition",
+				MarkdownDescription: "Partition in which the bucket is located",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f s3URIToARNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var uri, partition string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &uri, &partition))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := s3uri.ValidatePartition(partition); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	location, err := s3uri.Parse(uri)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if location.AccessPoint != "" && location.Partition != partition {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("access point partition (%s) does not match partition (%s)", location.Partition, partition)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, location.ARN(partition)))
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3URIToARNFunction_bucket(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIToARNFunctionConfig("s3://example-bucket/path/to/object.txt", "aws-us-gov"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "arn:aws-us-gov:s3:::example-bucket/path/to/object.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIToARNFunction_accessPoint(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIToARNFunctionConfig("s3://arn:aws:s3:us-west-2:123456789012:accesspoint/example/path/to/object.txt", "aws"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "arn:aws:s3:us-west-2:123456789012:accesspoint/example/object/path/to/object.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIToARNFunction_invalidPartition(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIToARNFunctionConfig("s3://example-bucket", "invalid"),
+				ExpectError: regexache.MustCompile(`partition[\s\n]*must[\s\n]*be[\s\n]*one[\s\n]*of`),
+			},
+		},
+	})
+}
+
+func testS3URIToARNFunctionConfig(uri, partition string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::s3_uri_to_arn(%[1]q, %[2]q)
+}
+`, uri, partition)
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"time"
+	_ "time/tzdata" // Embed the IANA Time Zone database so that time zones can be loaded on all platforms.
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+const (
+	scheduleExpressionNextMaxCount = 100
+)
+
+var _ function.Function = scheduleExpressionNextFunction{}
+
+func NewScheduleExpressionNextFunction() function.Function {
+	return &scheduleExpressionNextFunction{}
+}
+
+type scheduleExpressionNextFunction struct{}
+
+func (f scheduleExpressionNextFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_expression_next"
+}
+
+func (f scheduleExpressionNextFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "schedule_expression_next Function",
+		MarkdownDescription: "Validates an AWS `cron()`, `rate()` or `at()` schedule expression and returns its next fire times " +
+			"as RFC 3339 timestamps.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression",
+			},
+			function.StringParameter{
+				Name:                "timezone",
+				MarkdownDescription: "IANA time zone in which the schedule expression is evaluated, for example `UTC` or `America/New_York`",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: fmt.Sprintf("Number of fire times to return, between 1 and %d", scheduleExpressionNextMaxCount),
+				Validators: []function.Int64ParameterValidator{
+					int64validator.Between(1, scheduleExpressionNextMaxCount),
+				},
+			},
+			function.StringParameter{
+				Name:                "start",
+				MarkdownDescription: "RFC 3339 timestamp after which fire times are calculated, for example the result of `plantimestamp()`",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f scheduleExpressionNextFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression, timezone, startTimestamp string
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &timezone, &count, &startTimestamp))
+	if resp.Error != nil {
+		return
+	}
+
+	e, err := schedule.Parse(expression)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("invalid time zone %q: %s", timezone, err)))
+		return
+	}
+
+	// The start time is required so that the function's result is deterministic.
+	start, err := time.Parse(time.RFC3339, startTimestamp)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, fmt.Sprintf("invalid RFC 3339 timestamp %q: %s", startTimestamp, err)))
+		return
+	}
+
+	result := make([]string, 0, count)
+	for _, t := range schedule.NextN(e, start.In(loc), int(count)) {
+		result = append(result, t.Format(time.RFC3339))
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
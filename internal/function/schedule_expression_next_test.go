@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestScheduleExpressionNextFunction_cron(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(0 9 ? * MON-FRI *)", "America/New_York", 2, "2026-03-06T15:30:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2026-03-09T09:00:00-04:00,2026-03-10T09:00:00-04:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_rate(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("rate(12 hours)", "UTC", 2, "2026-03-06T15:30:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2026-03-07T03:30:00Z,2026-03-07T15:30:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_invalidExpression(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("cron(0 9 * * ?)", "UTC", 1, "2026-03-06T15:30:00Z"),
+				ExpectError: regexache.MustCompile(`must[\s\n]*have[\s\n]*6[\s\n]*fields`),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_invalidTimezone(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("rate(1 day)", "Mars/Olympus_Mons", 1, "2026-03-06T15:30:00Z"),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*time[\s\n]*zone`),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_invalidStart(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("rate(1 day)", "UTC", 1, ""),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*RFC[\s\n]*3339[\s\n]*timestamp`),
+			},
+		},
+	})
+}
+
+func testScheduleExpressionNextFunctionConfig(expression, timezone string, count int, start string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::schedule_expression_next(%[1]q, %[2]q, %[3]d, %[4]q))
+}
+`, expression, timezone, count, start)
+}
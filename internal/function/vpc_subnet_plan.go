@@ -0,0 +1,257 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// The allowed IPv4 subnet block size is between a /16 netmask and /28 netmask.
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+	vpcSubnetPlanMinIPv4PrefixLength = 16
+	vpcSubnetPlanMaxIPv4PrefixLength = 28
+
+	// IPv6 subnets always use a /64 netmask.
+	vpcSubnetPlanIPv6PrefixLength = 64
+)
+
+var vpcSubnetPlanTierAttrTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"newbits": types.Int64Type,
+}
+
+var vpcSubnetPlanResultAttrTypes = map[string]attr.Type{
+	"cidr_blocks":      types.ListType{ElemType: types.StringType},
+	"ipv6_cidr_blocks": types.ListType{ElemType: types.StringType},
+}
+
+var _ function.Function = vpcSubnetPlanFunction{}
+
+func NewVPCSubnetPlanFunction() function.Function {
+	return &vpcSubnetPlanFunction{}
+}
+
+type vpcSubnetPlanFunction struct{}
+
+type vpcSubnetPlanTier struct {
+	Name    string `tfsdk:"name"`
+	Newbits int64  `tfsdk:"newbits"`
+}
+
+type vpcSubnetPlanTierResult struct {
+	cidrBlocks     []string
+	ipv6CIDRBlocks []string
+}
+
+func (f vpcSubnetPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vpc_subnet_plan"
+}
+
+func (f vpcSubnetPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "vpc_subnet_plan Function",
+		MarkdownDescription: "Plans non-overlapping subnet CIDR blocks for each tier and Availability Zone of a VPC. " +
+			"Subnets are allocated in tier order and, within a tier, in Availability Zone order. " +
+			"If an IPv6 CIDR block is specified, each subnet is also assigned a /64 IPv6 CIDR block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_cidr",
+				CustomType:          fwtypes.CIDRBlockType,
+				MarkdownDescription: "IPv4 CIDR block of the VPC",
+			},
+			function.Int64Parameter{
+				Name:                "az_count",
+				MarkdownDescription: "Number of Availability Zones in which to plan subnets",
+				Validators: []function.Int64ParameterValidator{
+					int64validator.Between(1, 1<<(vpcSubnetPlanMaxIPv4PrefixLength-vpcSubnetPlanMinIPv4PrefixLength)),
+				},
+			},
+			function.ListParameter{
+				Name:                "tiers",
+				ElementType:         types.ObjectType{AttrTypes: vpcSubnetPlanTierAttrTypes},
+				MarkdownDescription: "Subnet tiers, each with a unique `name` and the number of additional prefix bits (`newbits`) to add to the VPC CIDR block",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "ipv6_cidr",
+			CustomType:          fwtypes.CIDRBlockType,
+			MarkdownDescription: "Optional IPv6 CIDR block of the VPC from which /64 subnet CIDR blocks are derived",
+		},
+		Return: function.MapReturn{
+			ElementType: types.ObjectType{AttrTypes: vpcSubnetPlanResultAttrTypes},
+		},
+	}
+}
+
+func (f vpcSubnetPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDR string
+	var azCount int64
+	var tiers []vpcSubnetPlanTier
+	var ipv6CIDRs []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDR, &azCount, &tiers, &ipv6CIDRs))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(ipv6CIDRs) > 1 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, "at most one IPv6 CIDR block may be specified"))
+		return
+	}
+
+	var ipv6CIDR string
+	if len(ipv6CIDRs) == 1 {
+		ipv6CIDR = ipv6CIDRs[0]
+	}
+
+	plan, err := planVPCSubnets(vpcCIDR, int(azCount), tiers, ipv6CIDR)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	value := make(map[string]attr.Value, len(plan))
+	for name, tier := range plan {
+		cidrBlocks, d := types.ListValueFrom(ctx, types.StringType, tier.cidrBlocks)
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+
+		ipv6CIDRBlocks, d := types.ListValueFrom(ctx, types.StringType, tier.ipv6CIDRBlocks)
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+
+		value[name], d = types.ObjectValue(vpcSubnetPlanResultAttrTypes, map[string]attr.Value{
+			"cidr_blocks":      cidrBlocks,
+			"ipv6_cidr_blocks": ipv6CIDRBlocks,
+		})
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+	}
+
+	result, d := types.MapValue(types.ObjectType{AttrTypes: vpcSubnetPlanResultAttrTypes}, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// planVPCSubnets allocates subnet CIDR blocks for each tier and Availability Zone.
+// IPv4 subnets are allocated sequentially from the start of the VPC CIDR block, each aligned
+// to its own size, so the resulting plan is deterministic and never overlaps.
+// If ipv6CIDR is not empty, the n-th subnet planned is also assigned the n-th /64 of the IPv6 CIDR block.
+func planVPCSubnets(vpcCIDR string, azCount int, tiers []vpcSubnetPlanTier, ipv6CIDR string) (map[string]vpcSubnetPlanTierResult, error) {
+	if err := inttypes.ValidateIPv4CIDRBlock(vpcCIDR); err != nil {
+		return nil, err
+	}
+
+	// net.ParseCIDR accepts some forms, such as a leading zero in the prefix length, that netip rejects.
+	vpcPrefix, err := netip.ParsePrefix(vpcCIDR)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid CIDR block: %w", vpcCIDR, err)
+	}
+	if bits := vpcPrefix.Bits(); bits < vpcSubnetPlanMinIPv4PrefixLength || bits > vpcSubnetPlanMaxIPv4PrefixLength {
+		return nil, fmt.Errorf("VPC CIDR block prefix length must be between /%d and /%d", vpcSubnetPlanMinIPv4PrefixLength, vpcSubnetPlanMaxIPv4PrefixLength)
+	}
+
+	if azCount < 1 {
+		return nil, errors.New("az_count must be at least 1")
+	}
+
+	// Every tier needs a subnet per Availability Zone and at most 2^(28-prefix length) of the smallest allowed subnets fit in the VPC.
+	// Check this before sizing anything by az_count.
+	if maxAZCount := 1 << (vpcSubnetPlanMaxIPv4PrefixLength - vpcPrefix.Bits()); azCount > maxAZCount {
+		return nil, fmt.Errorf("VPC CIDR block %s has insufficient space for %d Availability Zones, at most %d /%d subnets fit", vpcCIDR, azCount, maxAZCount, vpcSubnetPlanMaxIPv4PrefixLength)
+	}
+
+	if len(tiers) == 0 {
+		return nil, errors.New("at least one tier must be specified")
+	}
+
+	var ipv6Prefix netip.Prefix
+	if ipv6CIDR != "" {
+		if err := inttypes.ValidateIPv6CIDRBlock(ipv6CIDR); err != nil {
+			return nil, err
+		}
+
+		var err error
+		ipv6Prefix, err = netip.ParsePrefix(ipv6CIDR)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid CIDR block: %w", ipv6CIDR, err)
+		}
+		if bits := ipv6Prefix.Bits(); bits > vpcSubnetPlanIPv6PrefixLength {
+			return nil, fmt.Errorf("IPv6 CIDR block prefix length must be at most /%d", vpcSubnetPlanIPv6PrefixLength)
+		} else if n, shift := uint64(len(tiers)*azCount), vpcSubnetPlanIPv6PrefixLength-bits; shift < 64 && n > uint64(1)<<shift {
+			return nil, fmt.Errorf("IPv6 CIDR block %s is too small for %d /%d subnets", ipv6CIDR, n, vpcSubnetPlanIPv6PrefixLength)
+		}
+	}
+
+	vpcStart := uint64(binary.BigEndian.Uint32(vpcPrefix.Addr().AsSlice()))
+	vpcEnd := vpcStart + uint64(1)<<(32-vpcPrefix.Bits())
+	next := vpcStart
+	n := uint64(0)
+
+	plan := make(map[string]vpcSubnetPlanTierResult, len(tiers))
+	for i, tier := range tiers {
+		if tier.Name == "" {
+			return nil, fmt.Errorf("tiers[%d]: name must not be empty", i)
+		}
+		if _, ok := plan[tier.Name]; ok {
+			return nil, fmt.Errorf("tiers[%d]: duplicate tier name %q", i, tier.Name)
+		}
+
+		bits := int64(vpcPrefix.Bits()) + tier.Newbits
+		if tier.Newbits < 0 || bits > vpcSubnetPlanMaxIPv4PrefixLength {
+			return nil, fmt.Errorf("tiers[%d]: newbits must be between 0 and %d", i, vpcSubnetPlanMaxIPv4PrefixLength-vpcPrefix.Bits())
+		}
+		size := uint64(1) << (32 - bits)
+
+		result := vpcSubnetPlanTierResult{
+			cidrBlocks:     make([]string, 0, azCount),
+			ipv6CIDRBlocks: make([]string, 0, azCount),
+		}
+		for range azCount {
+			// Align the subnet to its own size.
+			start := (next + size - 1) &^ (size - 1)
+			if start+size > vpcEnd {
+				return nil, fmt.Errorf("tiers[%d]: VPC CIDR block %s has insufficient space for %d /%d subnets", i, vpcCIDR, azCount, bits)
+			}
+			next = start + size
+
+			var addr [4]byte
+			binary.BigEndian.PutUint32(addr[:], uint32(start))
+			result.cidrBlocks = append(result.cidrBlocks, netip.PrefixFrom(netip.AddrFrom4(addr), int(bits)).String())
+
+			if ipv6Prefix.IsValid() {
+				addr := ipv6Prefix.Addr().As16()
+				binary.BigEndian.PutUint64(addr[:8], binary.BigEndian.Uint64(addr[:8])+n)
+				result.ipv6CIDRBlocks = append(result.ipv6CIDRBlocks, netip.PrefixFrom(netip.AddrFrom16(addr), vpcSubnetPlanIPv6PrefixLength).String())
+			}
+			n++
+		}
+
+		plan[tier.Name] = result
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestVPCSubnetPlanFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCSubnetPlanFunctionConfig_ipv4("10.0.0.0/16", 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("public", "10.0.0.0/24,10.0.1.0/24,10.0.2.0/24"),
+					resource.TestCheckOutput("private", "10.0.16.0/20,10.0.32.0/20,10.0.48.0/20"),
+					resource.TestCheckOutput("public_ipv6", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCSubnetPlanFunctionConfig_ipv6("10.0.0.0/16", 2, "2600:1f18:abcd:ef00::/56"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("public", "10.0.0.0/24,10.0.1.0/24"),
+					resource.TestCheckOutput("private", "10.0.16.0/20,10.0.32.0/20"),
+					resource.TestCheckOutput("public_ipv6", "2600:1f18:abcd:ef00::/64,2600:1f18:abcd:ef01::/64"),
+					resource.TestCheckOutput("private_ipv6", "2600:1f18:abcd:ef02::/64,2600:1f18:abcd:ef03::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_insufficientSpace(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig_ipv4("10.0.0.0/16", 17),
+				ExpectError: regexache.MustCompile(`insufficient[\s\n]*space`),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_oversizedAZCount(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig_ipv4("10.0.0.0/16", 1000000000000),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*between[\s\n]*1[\s\n]*and[\s\n]*4096`),
+			},
+			{
+				Config:      testVPCSubnetPlanFunctionConfig_ipv4("10.0.0.0/24", 17),
+				ExpectError: regexache.MustCompile(`insufficient[\s\n]*space[\s\n]*for[\s\n]*17[\s\n]*Availability[\s\n]*Zones`),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_invalidCIDR(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig_ipv4("10.0.0.1/16", 3),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_leadingZeroPrefixLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig_ipv4("10.0.0.0/016", 3),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block`),
+			},
+			{
+				Config:      testVPCSubnetPlanFunctionConfig_ipv6("10.0.0.0/16", 3, "2001:db8::/056"),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func testVPCSubnetPlanFunctionConfig_ipv4(vpcCIDR string, azCount int) string {
+	return fmt.Sprintf(`
+locals {
+  plan = provider::aws::vpc_subnet_plan(%[1]q, %[2]d, [
+    { name = "public", newbits = 8 },
+    { name = "private", newbits = 4 },
+  ])
+}
+
+output "public" {
+  value = join(",", local.plan["public"].cidr_blocks)
+}
+
+output "private" {
+  value = join(",", local.plan["private"].cidr_blocks)
+}
+
+output "public_ipv6" {
+  value = join(",", local.plan["public"].ipv6_cidr_blocks)
+}
+`, vpcCIDR, azCount)
+}
+
+func testVPCSubnetPlanFunctionConfig_ipv6(vpcCIDR string, azCount int, ipv6CIDR string) string {
+	return fmt.Sprintf(`
+locals {
+  plan = provider::aws::vpc_subnet_plan(%[1]q, %[2]d, [
+    { name = "public", newbits = 8 },
+    { name = "private", newbits = 4 },
+  ], %[3]q)
+}
+
+output "public" {
+  value = join(",", local.plan["public"].cidr_blocks)
+}
+
+output "private" {
+  value = join(",", local.plan["private"].cidr_blocks)
+}
+
+output "public_ipv6" {
+  value = join(",", local.plan["public"].ipv6_cidr_blocks)
+}
+
+output "private_ipv6" {
+  value = join(",", local.plan["private"].ipv6_cidr_blocks)
+}
+`, vpcCIDR, azCount, ipv6CIDR)
+}
@@ -215,6 +215,7 @@ type ResourceDatum struct {
 	regionOverrideEnabled             bool
 	RegionOverrideDeprecated          bool
 	ValidateRegionOverrideInPartition bool
+	AssumeRoleOverrideEnabled         bool
 	TransparentTagging                bool
 	TagsIdentifierAttribute           string
 	TagsResourceType                  string
@@ -399,6 +400,9 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					}
 				}
 
+			case "AssumeRole":
+				d.AssumeRoleOverrideEnabled = true
+
 			case "Tags":
 				d.TransparentTagging = true
 
@@ -720,7 +724,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkListResources[typeName] = d
 				}
 
-			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "NoImport", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity":
+			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "AssumeRole", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "NoImport", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity":
 				// Handled above.
 			case "ArnFormat", "IdAttrFormat", "Testing":
 				// Ignored.
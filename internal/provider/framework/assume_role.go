@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+)
+
+type resourceInjectAssumeRoleAttributeInterceptor struct{}
+
+func (r resourceInjectAssumeRoleAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[resource.SchemaRequest, resource.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Blocks["assume_role"]; !ok {
+			// Inject a top-level "assume_role" block.
+			if response.Schema.Blocks == nil {
+				response.Schema.Blocks = make(map[string]schema.Block)
+			}
+			response.Schema.Blocks["assume_role"] = resourceattribute.AssumeRole(ctx)
+		}
+	}
+}
+
+// resourceInjectAssumeRoleAttribute injects a top-level "assume_role" block into a resource's schema.
+func resourceInjectAssumeRoleAttribute() resourceSchemaInterceptor {
+	return &resourceInjectAssumeRoleAttributeInterceptor{}
+}
+
+type resourceForceNewIfAssumeRoleAccountChangesInterceptor struct{}
+
+func (r resourceForceNewIfAssumeRoleAccountChangesInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			return
+		}
+
+		// If the entire state is null, the resource is new.
+		if request.State.Raw.IsNull() {
+			return
+		}
+
+		var planAssumeRole fwtypes.ListNestedObjectValueOf[framework.AssumeRoleModel]
+		response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("assume_role"), &planAssumeRole)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		planData, d := planAssumeRole.ToPtr(ctx)
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// The account cannot be determined until the role ARN is known, so assume that it changes.
+		if planAssumeRole.IsUnknown() || (planData != nil && planData.RoleARN.IsUnknown()) {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root("assume_role"))
+			return
+		}
+
+		var planRoleARN string
+		if planData != nil {
+			planRoleARN = planData.RoleARN.ValueString()
+		}
+
+		stateOverride, _, d := assumeRoleOverride(ctx, request.State.GetAttribute)
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Context has any new override in effect, so look up the provider's own account ID without it.
+		providerAccountID := c.AccountID(context.Background())
+		if assumeRoleAccountID(planRoleARN, providerAccountID) != assumeRoleAccountID(stateOverride.RoleARN, providerAccountID) {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root("assume_role"))
+		}
+	}
+}
+
+// resourceForceNewIfAssumeRoleAccountChanges forces resource replacement if a change to the top-level "assume_role" block
+// changes the AWS account in which the resource is managed.
+func resourceForceNewIfAssumeRoleAccountChanges() resourceModifyPlanInterceptor {
+	return &resourceForceNewIfAssumeRoleAccountChangesInterceptor{}
+}
+
+// assumeRoleOverride returns any per-resource assume role override defined by the top-level "assume_role" block.
+func assumeRoleOverride(ctx context.Context, getAttribute getAttributeFunc) (conns.AssumeRoleOverride, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var v conns.AssumeRoleOverride
+
+	var target fwtypes.ListNestedObjectValueOf[framework.AssumeRoleModel]
+	diags.Append(getAttribute(ctx, path.Root("assume_role"), &target)...)
+	if diags.HasError() {
+		return v, false, diags
+	}
+
+	data, d := target.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return v, false, diags
+	}
+
+	v.ExternalID = data.ExternalID.ValueString()
+	v.RoleARN = data.RoleARN.ValueString()
+	v.SessionName = data.SessionName.ValueString()
+
+	return v, v.RoleARN != "", diags
+}
+
+// assumeRoleAccountID returns the AWS account ID of the specified role,
+// or defaultAccountID if no role is specified.
+func assumeRoleAccountID(roleARN, defaultAccountID string) string {
+	if arn, err := arn.Parse(roleARN); err == nil {
+		return arn.AccountID
+	}
+
+	return defaultAccountID
+}
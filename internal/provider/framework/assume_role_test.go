@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+)
+
+func TestResourceInjectAssumeRoleAttribute(t *testing.T) {
+	t.Parallel()
+
+	interceptors := interceptorInvocations{resourceInjectAssumeRoleAttribute()}
+
+	f := func(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+		response.Schema = schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		}
+	}
+
+	handler := interceptedHandler(interceptors.resourceSchema(), f, resourceSchemaHasError, mockClient{})
+
+	var response resource.SchemaResponse
+	handler(t.Context(), resource.SchemaRequest{}, &response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", response.Diagnostics)
+	}
+
+	if _, ok := response.Schema.Blocks["assume_role"]; !ok {
+		t.Error("assume_role block not injected")
+	}
+}
+
+func TestAssumeRoleOverride(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	s := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"assume_role": resourceattribute.AssumeRole(ctx),
+		},
+	}
+	objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+	blockType := objectType.AttributeTypes["assume_role"].(tftypes.List)
+	elementType := blockType.ElementType.(tftypes.Object)
+
+	testCases := map[string]struct {
+		elements   []tftypes.Value
+		expected   conns.AssumeRoleOverride
+		expectedOK bool
+	}{
+		"no block": {},
+		"role ARN": {
+			elements: []tftypes.Value{
+				tftypes.NewValue(elementType, map[string]tftypes.Value{
+					"external_id":  tftypes.NewValue(tftypes.String, nil),
+					"role_arn":     tftypes.NewValue(tftypes.String, "arn:aws:iam::123456789012:role/test"), //lintignore:AWSAT005
+					"session_name": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			expected: conns.AssumeRoleOverride{
+				RoleARN: "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+			},
+			expectedOK: true,
+		},
+		"all": {
+			elements: []tftypes.Value{
+				tftypes.NewValue(elementType, map[string]tftypes.Value{
+					"external_id":  tftypes.NewValue(tftypes.String, "external"),
+					"role_arn":     tftypes.NewValue(tftypes.String, "arn:aws:iam::123456789012:role/test"), //lintignore:AWSAT005
+					"session_name": tftypes.NewValue(tftypes.String, "session"),
+				}),
+			},
+			expected: conns.AssumeRoleOverride{
+				ExternalID:  "external",
+				RoleARN:     "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+				SessionName: "session",
+			},
+			expectedOK: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := tfsdk.State{
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"assume_role": tftypes.NewValue(blockType, testCase.elements),
+				}),
+				Schema: s,
+			}
+
+			got, ok, diags := assumeRoleOverride(ctx, state.GetAttribute)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %s", diags)
+			}
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Errorf("ok = %v, want %v", got, want)
+			}
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceForceNewIfAssumeRoleAccountChangesInterceptor_ModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	const (
+		providerAccountID = "111111111111"
+		roleARN1          = "arn:aws:iam::222222222222:role/test1" //lintignore:AWSAT005
+		roleARN2          = "arn:aws:iam::222222222222:role/test2" //lintignore:AWSAT005
+		roleARN3          = "arn:aws:iam::333333333333:role/test"  //lintignore:AWSAT005
+		providerRoleARN   = "arn:aws:iam::111111111111:role/test"  //lintignore:AWSAT005
+	)
+
+	ctx := t.Context()
+	client := mockClient{accountID: providerAccountID}
+	icpt := resourceForceNewIfAssumeRoleAccountChangesInterceptor{}
+
+	s := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"assume_role": resourceattribute.AssumeRole(ctx),
+		},
+	}
+	objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+	blockType := objectType.AttributeTypes["assume_role"].(tftypes.List)
+	elementType := blockType.ElementType.(tftypes.Object)
+
+	assumeRole := func(roleARN any) tftypes.Value {
+		var elements []tftypes.Value
+		if roleARN != nil {
+			elements = append(elements, tftypes.NewValue(elementType, map[string]tftypes.Value{
+				"external_id":  tftypes.NewValue(tftypes.String, nil),
+				"role_arn":     tftypes.NewValue(tftypes.String, roleARN),
+				"session_name": tftypes.NewValue(tftypes.String, nil),
+			}))
+		}
+
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"assume_role": tftypes.NewValue(blockType, elements),
+		})
+	}
+
+	testCases := map[string]struct {
+		state         tftypes.Value
+		plan          tftypes.Value
+		expectReplace bool
+	}{
+		"create": {
+			state: tftypes.NewValue(objectType, nil),
+			plan:  assumeRole(roleARN1),
+		},
+		"destroy": {
+			state: assumeRole(roleARN1),
+			plan:  tftypes.NewValue(objectType, nil),
+		},
+		"no role": {
+			state: assumeRole(nil),
+			plan:  assumeRole(nil),
+		},
+		"same account": {
+			state: assumeRole(roleARN1),
+			plan:  assumeRole(roleARN2),
+		},
+		"different account": {
+			state:         assumeRole(roleARN1),
+			plan:          assumeRole(roleARN3),
+			expectReplace: true,
+		},
+		"role added": {
+			state:         assumeRole(nil),
+			plan:          assumeRole(roleARN1),
+			expectReplace: true,
+		},
+		"role removed": {
+			state:         assumeRole(roleARN1),
+			plan:          assumeRole(nil),
+			expectReplace: true,
+		},
+		"role in provider account added": {
+			state: assumeRole(nil),
+			plan:  assumeRole(providerRoleARN),
+		},
+		"role unknown": {
+			state:         assumeRole(roleARN1),
+			plan:          assumeRole(tftypes.UnknownValue),
+			expectReplace: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := resource.ModifyPlanRequest{
+				State: tfsdk.State{Raw: testCase.state, Schema: s},
+				Plan:  tfsdk.Plan{Raw: testCase.plan, Schema: s},
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: request.Plan,
+			}
+
+			icpt.modifyPlan(ctx, interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c:        client,
+				request:  &request,
+				response: &response,
+				when:     Before,
+			})
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", response.Diagnostics)
+			}
+
+			var expected path.Paths
+			if testCase.expectReplace {
+				expected = path.Paths{path.Root("assume_role")}
+			}
+			if diff := cmp.Diff(response.RequiresReplace, expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// resourceConcurrencyLimitInterceptor limits the number of concurrent Create, Update and Delete operations for a resource type.
+// It must be the last interceptor in the chain so that a semaphore acquired Before is always released Finally.
+type resourceConcurrencyLimitInterceptor struct {
+	resourceNoOpCRUDInterceptor
+	typeName string
+}
+
+func (r resourceConcurrencyLimitInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) {
+	r.run(ctx, opts.c, opts.when, &opts.response.Diagnostics)
+}
+
+func (r resourceConcurrencyLimitInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) {
+	r.run(ctx, opts.c, opts.when, &opts.response.Diagnostics)
+}
+
+func (r resourceConcurrencyLimitInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) {
+	r.run(ctx, opts.c, opts.when, &opts.response.Diagnostics)
+}
+
+func (r resourceConcurrencyLimitInterceptor) run(ctx context.Context, c awsClient, when when, diags *diag.Diagnostics) {
+	semaphore, ok := c.ResourceConcurrencySemaphore(ctx, r.typeName)
+	if !ok {
+		return
+	}
+
+	switch when {
+	case Before:
+		tflog.Debug(ctx, "Waiting for resource concurrency limit", map[string]any{
+			"concurrency_limit": cap(semaphore),
+		})
+		if err := semaphore.WaitContext(ctx); err != nil {
+			diags.AddError(fmt.Sprintf("waiting for %s concurrency limit", r.typeName), err.Error())
+		}
+	case Finally:
+		semaphore.Notify()
+	}
+}
+
+func resourceConcurrencyLimit(typeName string) resourceCRUDInterceptor {
+	return &resourceConcurrencyLimitInterceptor{
+		typeName: typeName,
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+func TestResourceConcurrencyLimit(t *testing.T) {
+	t.Parallel()
+
+	const typeName = "aws_test"
+
+	testCases := map[string]struct {
+		innerFuncDiags diag.Diagnostics
+	}{
+		"success": {},
+		"error": {
+			innerFuncDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Create error", "An error occurred"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			semaphore := tfsync.NewSemaphore(2)
+			client := mockClient{
+				semaphores: map[string]tfsync.Semaphore{
+					typeName: semaphore,
+				},
+			}
+
+			interceptors := interceptorInvocations{resourceConcurrencyLimit(typeName)}
+
+			var inFlight int
+			f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+				inFlight = len(semaphore)
+				response.Diagnostics.Append(testCase.innerFuncDiags...)
+			}
+
+			handler := interceptedHandler(interceptors.resourceCreate(), f, resourceCreateHasError, client)
+
+			handler(t.Context(), resource.CreateRequest{}, &resource.CreateResponse{})
+
+			if got, want := inFlight, 1; got != want {
+				t.Errorf("in-flight operations = %d, want %d", got, want)
+			}
+
+			if got, want := len(semaphore), 0; got != want {
+				t.Errorf("in-flight operations after handler = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestResourceConcurrencyLimitCancelled(t *testing.T) {
+	t.Parallel()
+
+	const typeName = "aws_test"
+
+	semaphore := tfsync.NewSemaphore(1)
+	semaphore.Wait()
+	client := mockClient{
+		semaphores: map[string]tfsync.Semaphore{
+			typeName: semaphore,
+		},
+	}
+
+	interceptors := interceptorInvocations{resourceConcurrencyLimit(typeName)}
+
+	var count int
+	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+		count++
+	}
+
+	handler := interceptedHandler(interceptors.resourceDelete(), f, resourceDeleteHasError, client)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	var response resource.DeleteResponse
+	handler(ctx, resource.DeleteRequest{}, &response)
+
+	if !response.Diagnostics.HasError() {
+		t.Error("expected error")
+	}
+
+	if count != 0 {
+		t.Errorf("expected inner function to not be called, got %d", count)
+	}
+
+	if got, want := len(semaphore), 1; got != want {
+		t.Errorf("in-flight operations after handler = %d, want %d", got, want)
+	}
+}
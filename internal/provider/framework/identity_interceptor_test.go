@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -374,8 +375,10 @@ func emtpyIdentityFromSchema(ctx context.Context, schema *identityschema.Schema)
 }
 
 type mockClient struct {
-	accountID string
-	region    string
+	accountID  string
+	readOnly   bool
+	region     string
+	semaphores map[string]tfsync.Semaphore
 }
 
 func (c mockClient) AccountID(_ context.Context) string {
@@ -414,6 +417,15 @@ func (c mockClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) ReadOnly(context.Context) bool {
+	return c.readOnly
+}
+
+func (c mockClient) ResourceConcurrencySemaphore(_ context.Context, typeName string) (tfsync.Semaphore, bool) {
+	v, ok := c.semaphores[typeName]
+	return v, ok
+}
+
 func TestIdentityIsFullyNull(t *testing.T) {
 	t.Parallel()
 
@@ -109,6 +109,15 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"api_call_log": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which a JSON line is appended for each AWS API call.",
+			},
+			"concurrency_limits": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Map of resource type names, e.g. `aws_lambda_function`, to the maximum number of concurrent Create, Update and Delete operations.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -149,6 +158,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to refuse to create, update or delete resources and to call AWS API operations that modify resources. Can also be enabled using the `TF_AWS_READ_ONLY` environment variable.",
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
@@ -204,7 +217,7 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 			"tag_policy_compliance": schema.StringAttribute{
 				Optional: true,
 				Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-					`At this time this only includes compliance with required tag keys by resource type. ` +
+					`This includes compliance with required tag keys, tag key capitalization, and allowed tag values by resource type. ` +
 					`Valid values are "error", "warning", and "disabled". ` +
 					`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 					`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
@@ -329,6 +342,40 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Configuration blocks with resource tags to default across a subset of resources. Rules are merged in order over `tags`.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types, e.g. `aws_route53_record`, the rule does not apply to.",
+									},
+									"exclude_services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Service packages, e.g. `iam`, the rule does not apply to.",
+									},
+									"include_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types, e.g. `aws_instance`, the rule applies to.",
+									},
+									"include_services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Service packages, e.g. `ec2`, the rule applies to.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Resource tags to default across the resources the rule applies to.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -345,6 +392,16 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 							Description: "Resource tag key prefixes to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 						},
+						"key_regexes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"key_suffixes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag key suffixes to ignore across all resources.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -352,6 +409,71 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 								"Can also be configured with the " + tftags.IgnoreTagsKeysEnvVar + " environment variable.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"value_pattern": schema.ListNestedBlock{
+							Description: "Configuration blocks with resource tags to ignore across all resources only when their values match a regular expression.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrKey: schema.StringAttribute{
+										Required:    true,
+										Description: "Resource tag key to ignore.",
+									},
+									"value_regex": schema.StringAttribute{
+										Required:    true,
+										Description: "Regular expression matching the resource tag values to ignore.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"rate_limit": schema.ListNestedBlock{
+				Description: "Configuration blocks with client-side rate limits for AWS API requests.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests that can be made at once. Defaults to `requests_per_second`, rounded up.",
+						},
+						"operation": schema.StringAttribute{
+							Optional:    true,
+							Description: "The API operation, e.g. `ChangeResourceRecordSets`, to limit. If not set, all of the service's operations are limited.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Required:    true,
+							Description: "The maximum rate of requests. The rate is reduced when throttling errors are returned.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, e.g. `route53`, to limit.",
+						},
+					},
+				},
+			},
+			"retry": schema.ListNestedBlock{
+				Description: "Configuration blocks with per-service overrides of the retry behavior of AWS API requests.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of attempts, including the initial request, of an API call. Overrides `max_retries`.",
+						},
+						"max_backoff": schema.StringAttribute{
+							CustomType:  timetypes.GoDurationType{},
+							Optional:    true,
+							Description: "The maximum delay between attempts. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"retryable_error_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Additional API error codes, e.g. `ConcurrentModificationException`, that are retried.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, e.g. `iam`, to configure.",
+						},
+					},
 				},
 			},
 		},
@@ -424,8 +546,16 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewIAMPolicyEqualFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewS3ARNToURIFunction,
+		tffunction.NewS3URIParseFunction,
+		tffunction.NewS3URIToARNFunction,
+		tffunction.NewScheduleExpressionNextFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 		tffunction.NewUserAgentFunction,
+		tffunction.NewVPCSubnetPlanFunction,
 	}
 }
 
@@ -570,6 +700,11 @@ func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
 				continue
 			}
 
+			if err := validateSchemaAssumeRoleForResource(resourceSpec.AssumeRole, schemaResponse.Schema); err != nil {
+				errs = append(errs, fmt.Errorf("resource type %q: %w", typeName, err))
+				continue
+			}
+
 			if err := validateSchemaTagsForResource(resourceSpec.Tags, schemaResponse.Schema); err != nil {
 				errs = append(errs, fmt.Errorf("resource type %q: %w", typeName, err))
 				continue
@@ -632,6 +767,18 @@ func validateSchemaRegionForResource(regionSpec unique.Handle[inttypes.ServicePa
 	return nil
 }
 
+func validateSchemaAssumeRoleForResource(isAssumeRoleOverrideEnabled bool, schema resourceschema.Schema) error {
+	if isAssumeRoleOverrideEnabled {
+		if _, ok := schema.Attributes["assume_role"]; ok {
+			return errors.New("configured for assume role override but defines `assume_role` attribute in schema")
+		}
+		if _, ok := schema.Blocks["assume_role"]; ok {
+			return errors.New("configured for assume role override but defines `assume_role` block in schema")
+		}
+	}
+	return nil
+}
+
 func validateSchemaTagsForDataSource(tagsSpec unique.Handle[inttypes.ServicePackageResourceTags], schema datasourceschema.Schema) error {
 	if !tfunique.IsHandleNil(tagsSpec) {
 		if v, ok := schema.Attributes[names.AttrTags]; ok {
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// resourceReadOnlyInterceptor refuses Create, Update and Delete operations when the provider is in read-only mode.
+type resourceReadOnlyInterceptor struct {
+	resourceNoOpCRUDInterceptor
+	typeName string
+}
+
+func (r resourceReadOnlyInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) {
+	r.run(ctx, opts.c, opts.when, &opts.response.Diagnostics)
+}
+
+func (r resourceReadOnlyInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) {
+	r.run(ctx, opts.c, opts.when, &opts.response.Diagnostics)
+}
+
+func (r resourceReadOnlyInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) {
+	r.run(ctx, opts.c, opts.when, &opts.response.Diagnostics)
+}
+
+func (r resourceReadOnlyInterceptor) run(ctx context.Context, c awsClient, when when, diags *diag.Diagnostics) {
+	if when != Before || !c.ReadOnly(ctx) {
+		return
+	}
+
+	diags.AddError(
+		"Provider in read-only mode",
+		fmt.Sprintf("%s resources can't be created, updated or deleted as the provider is in read-only mode. "+
+			"Read-only mode is enabled by the provider's read_only argument or the TF_AWS_READ_ONLY environment variable.", r.typeName),
+	)
+}
+
+func resourceReadOnly(typeName string) resourceCRUDInterceptor {
+	return &resourceReadOnlyInterceptor{
+		typeName: typeName,
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestResourceReadOnly(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		readOnly           bool
+		expectedError      bool
+		expectedInnerCalls int
+	}{
+		"not read-only": {
+			expectedInnerCalls: 1,
+		},
+		"read-only": {
+			readOnly:      true,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := mockClient{
+				readOnly: testCase.readOnly,
+			}
+
+			interceptors := interceptorInvocations{resourceReadOnly("aws_test")}
+
+			var count int
+			f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+				count++
+			}
+
+			handler := interceptedHandler(interceptors.resourceUpdate(), f, resourceUpdateHasError, client)
+
+			var response resource.UpdateResponse
+			handler(t.Context(), resource.UpdateRequest{}, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectedError; got != want {
+				t.Errorf("HasError() = %v, want %v", got, want)
+			}
+
+			if got, want := count, testCase.expectedInnerCalls; got != want {
+				t.Errorf("inner function calls = %d, want %d", got, want)
+			}
+		})
+	}
+}
@@ -4,9 +4,15 @@
 package resourceattribute
 
 import (
+	"context"
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -26,3 +32,33 @@ var RegionDeprecated = sync.OnceValue(func() schema.StringAttribute {
 		DeprecationMessage: "This attribute will be removed in a future version of the provider.",
 	}
 })
+
+func AssumeRole(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType:  fwtypes.NewListNestedObjectTypeOf[framework.AssumeRoleModel](ctx),
+		Description: names.ResourceTopLevelAssumeRoleAttributeDescription,
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrExternalID: schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(2, 1224),
+					},
+				},
+				names.AttrRoleARN: schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Required:   true,
+				},
+				"session_name": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(2, 64),
+					},
+				},
+			},
+		},
+	}
+}
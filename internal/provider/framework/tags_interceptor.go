@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -282,8 +283,9 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 	if policy == nil {
 		return
 	}
-	reqTags, ok := policy.RequiredTags[typeName]
-	if !ok {
+	reqTags, hasReqTags := policy.RequiredTags[typeName]
+	_, hasRules := policy.Rules[typeName]
+	if !hasReqTags && !hasRules {
 		return
 	}
 
@@ -315,21 +317,24 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 			return
 		}
 
-		if allPlanTags.ContainsAllKeys(reqTags) {
-			return
+		report := func(summary, detail string) {
+			switch policy.Severity {
+			case "warning":
+				opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
+			default:
+				opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+			}
 		}
 
-		missing := reqTags.Removed(allPlanTags).Keys()
-		slices.Sort(missing)
+		if !allPlanTags.ContainsAllKeys(reqTags) {
+			missing := reqTags.Removed(allPlanTags).Keys()
+			slices.Sort(missing)
 
-		summary := "Missing Required Tags"
-		detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
+			report("Missing Required Tags", fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing))
+		}
 
-		switch policy.Severity {
-		case "warning":
-			opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
-		default:
-			opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+		if nonCompliant := policy.NonCompliant(typeName, allPlanTags); len(nonCompliant) > 0 {
+			report("Non-Compliant Tags", fmt.Sprintf("An organizational tag policy does not allow the following tags for %s: %s", typeName, strings.Join(nonCompliant, ", ")))
 		}
 	}
 }
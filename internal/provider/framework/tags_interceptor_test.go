@@ -44,6 +44,11 @@ func (c mockRequiredTagsClient) TagPolicyConfig(ctx context.Context) *tftags.Tag
 				"bar": nil,
 			},
 		},
+		Rules: map[string][]tftags.TagPolicyRule{
+			"aws_test": {
+				{Key: "Env", Values: []string{"prod"}},
+			},
+		},
 	}
 }
 
@@ -117,6 +122,17 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 	}
 	rawValRequired := tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), attrsRequired)
 
+	// Non-compliant tags
+	attrsNonCompliant := map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "test"),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"foo": tftypes.NewValue(tftypes.String, nil),
+			"bar": tftypes.NewValue(tftypes.String, nil),
+			"env": tftypes.NewValue(tftypes.String, "dev"),
+		}),
+	}
+	rawValNonCompliant := tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), attrsNonCompliant)
+
 	// Unknown tag values
 	attrsUnknown := map[string]tftypes.Value{
 		"name": tftypes.NewValue(tftypes.String, "test"),
@@ -225,6 +241,39 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 				when: Before,
 			},
 		},
+		{
+			name: "create, non-compliant tags",
+			opts: interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c: mockRequiredTagsClient{},
+				request: &resource.ModifyPlanRequest{
+					Config: tfsdk.Config{
+						Raw:    rawValNonCompliant,
+						Schema: resourceSchema,
+					},
+					State: tfsdk.State{
+						Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil), // Raw state is null on creation
+						Schema: resourceSchema,
+					},
+					Plan: tfsdk.Plan{
+						Raw:    rawValNonCompliant,
+						Schema: resourceSchema,
+					},
+				},
+				response: &resource.ModifyPlanResponse{
+					Plan: tfsdk.Plan{
+						Raw:    rawValNonCompliant,
+						Schema: resourceSchema,
+					},
+				},
+				when: Before,
+			},
+			wantDiags: diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+				path.Root(names.AttrTags),
+				"Non-Compliant Tags",
+				`An organizational tag policy does not allow the following tags for aws_test: "env" must be capitalized as "Env", "env" value "dev" is not one of ["prod"]`,
+			),
+			},
+		},
 		{
 			name: "create, unknown tag values",
 			opts: interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
@@ -546,6 +546,14 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 
 	var interceptors interceptorInvocations
 
+	// The read-only interceptor must be first.
+	interceptors = append(interceptors, resourceReadOnly(spec.TypeName))
+
+	if spec.AssumeRole {
+		interceptors = append(interceptors, resourceInjectAssumeRoleAttribute())
+		interceptors = append(interceptors, resourceForceNewIfAssumeRoleAccountChanges())
+	}
+
 	if isRegionOverrideEnabled {
 		v := spec.Region.Value()
 
@@ -568,6 +576,13 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		interceptors = append(interceptors, resourceValidateRequiredTags())
 	}
 
+	if len(spec.Identity.Attributes) > 0 {
+		interceptors = append(interceptors, newIdentityInterceptor(spec.Identity.Attributes))
+	}
+
+	// The concurrency limit interceptor must be last.
+	interceptors = append(interceptors, resourceConcurrencyLimit(spec.TypeName))
+
 	inner, _ := spec.Factory(context.TODO())
 
 	if len(spec.Identity.Attributes) == 0 {
@@ -579,7 +594,6 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		}
 	}
 
-	interceptors = append(interceptors, newIdentityInterceptor(spec.Identity.Attributes))
 	if v, ok := inner.(framework.Identityer); ok {
 		v.SetIdentitySpec(spec.Identity)
 	}
@@ -623,6 +637,19 @@ func (w *wrappedResource) context(ctx context.Context, getAttribute getAttribute
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+
+	if w.spec.AssumeRole && getAttribute != nil {
+		overrideAssumeRole, ok, d := assumeRoleOverride(ctx, getAttribute)
+		diags.Append(d...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		if ok {
+			ctx = conns.WithOverrideAssumeRole(ctx, overrideAssumeRole)
+		}
+	}
+
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
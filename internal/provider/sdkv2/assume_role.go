@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// assumeRoleOverride returns any per-resource assume role override defined by the top-level "assume_role" attribute.
+func assumeRoleOverride(getAttribute getAttributeFunc) (conns.AssumeRoleOverride, bool) {
+	var v conns.AssumeRoleOverride
+
+	if getAttribute == nil {
+		return v, false
+	}
+
+	raw, ok := getAttribute("assume_role")
+	if !ok {
+		return v, false
+	}
+
+	tfList, ok := raw.([]any)
+	if !ok || len(tfList) == 0 {
+		return v, false
+	}
+
+	tfMap, ok := tfList[0].(map[string]any)
+	if !ok {
+		return v, false
+	}
+
+	if s, ok := tfMap[names.AttrExternalID].(string); ok {
+		v.ExternalID = s
+	}
+	if s, ok := tfMap[names.AttrRoleARN].(string); ok {
+		v.RoleARN = s
+	}
+	if s, ok := tfMap["session_name"].(string); ok {
+		v.SessionName = s
+	}
+
+	return v, v.RoleARN != ""
+}
+
+// forceNewIfAssumeRoleAccountChanges forces resource replacement if a change to the top-level "assume_role" attribute
+// changes the AWS account in which the resource is managed.
+func forceNewIfAssumeRoleAccountChanges() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				if d.Id() != "" && d.HasChange("assume_role") {
+					// Context has any new override in effect, so look up the provider's own account ID without it.
+					providerAccountID := c.AccountID(context.Background())
+					o, n := d.GetChange("assume_role")
+					if assumeRoleAccountID(o, providerAccountID) == assumeRoleAccountID(n, providerAccountID) {
+						return nil
+					}
+					// Nested attributes don't inherit ForceNew from their block, so force replacement via the role ARN.
+					return d.ForceNew("assume_role.0." + names.AttrRoleARN)
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// assumeRoleAccountID returns the AWS account ID of the role in the specified "assume_role" attribute value,
+// or defaultAccountID if no role is specified.
+func assumeRoleAccountID(raw any, defaultAccountID string) string {
+	v, ok := assumeRoleOverride(func(string) (any, bool) {
+		return raw, true
+	})
+	if !ok {
+		return defaultAccountID
+	}
+
+	if arn, err := arn.Parse(v.RoleARN); err == nil {
+		return arn.AccountID
+	}
+
+	return defaultAccountID
+}
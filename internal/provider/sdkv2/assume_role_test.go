@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAssumeRoleOverride(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes map[string]any
+		expected   conns.AssumeRoleOverride
+		expectedOK bool
+	}{
+		"no attribute": {},
+		"empty": {
+			attributes: map[string]any{
+				"assume_role": []any{},
+			},
+		},
+		"role ARN": {
+			attributes: map[string]any{
+				"assume_role": []any{
+					map[string]any{
+						"role_arn": "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+					},
+				},
+			},
+			expected: conns.AssumeRoleOverride{
+				RoleARN: "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+			},
+			expectedOK: true,
+		},
+		"all": {
+			attributes: map[string]any{
+				"assume_role": []any{
+					map[string]any{
+						"external_id":  "external",
+						"role_arn":     "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+						"session_name": "session",
+					},
+				},
+			},
+			expected: conns.AssumeRoleOverride{
+				ExternalID:  "external",
+				RoleARN:     "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+				SessionName: "session",
+			},
+			expectedOK: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			getAttribute := func(key string) (any, bool) {
+				v, ok := testCase.attributes[key]
+				return v, ok
+			}
+
+			got, ok := assumeRoleOverride(getAttribute)
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Errorf("ok = %v, want %v", got, want)
+			}
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAssumeRoleAccountID(t *testing.T) {
+	t.Parallel()
+
+	const defaultAccountID = "123456789012"
+
+	testCases := map[string]struct {
+		raw      any
+		expected string
+	}{
+		"nil": {
+			expected: defaultAccountID,
+		},
+		"empty": {
+			raw:      []any{},
+			expected: defaultAccountID,
+		},
+		"same account": {
+			raw: []any{
+				map[string]any{
+					"role_arn": "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+				},
+			},
+			expected: defaultAccountID,
+		},
+		"other account": {
+			raw: []any{
+				map[string]any{
+					"role_arn": "arn:aws:iam::210987654321:role/test", //lintignore:AWSAT005
+				},
+			},
+			expected: "210987654321",
+		},
+		"invalid ARN": {
+			raw: []any{
+				map[string]any{
+					"role_arn": "test",
+				},
+			},
+			expected: defaultAccountID,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := assumeRoleAccountID(testCase.raw, defaultAccountID), testCase.expected; got != want {
+				t.Errorf("assumeRoleAccountID() = %q, want %q", got, want)
+			}
+		})
+	}
+}
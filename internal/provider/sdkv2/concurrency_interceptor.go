@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// resourceConcurrencyLimit limits the number of concurrent Create, Update and Delete operations for a resource type.
+// It must be the last interceptor in the chain so that a semaphore acquired Before is always released Finally.
+func resourceConcurrencyLimit(typeName string) crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		c := opts.c
+		var diags diag.Diagnostics
+
+		semaphore, ok := c.ResourceConcurrencySemaphore(ctx, typeName)
+		if !ok {
+			return diags
+		}
+
+		switch when, why := opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Create, Update, Delete:
+				tflog.Debug(ctx, "Waiting for resource concurrency limit", map[string]any{
+					"concurrency_limit": cap(semaphore),
+				})
+				if err := semaphore.WaitContext(ctx); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for %s concurrency limit: %s", typeName, err)
+				}
+			}
+		case Finally:
+			switch why {
+			case Create, Update, Delete:
+				semaphore.Notify()
+			}
+		}
+
+		return diags
+	})
+}
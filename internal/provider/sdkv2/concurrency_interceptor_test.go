@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+func TestResourceConcurrencyLimit(t *testing.T) {
+	t.Parallel()
+
+	const typeName = "aws_test"
+
+	contextFunc := func(ctx context.Context, _ getAttributeFunc, _ getProviderMetaFunc, meta any) (context.Context, error) {
+		return ctx, nil
+	}
+
+	testCases := map[string]struct {
+		why            why
+		innerFuncDiags diag.Diagnostics
+		wantInFlight   int
+	}{
+		"Create": {
+			why:          Create,
+			wantInFlight: 1,
+		},
+		"Create error": {
+			why: Create,
+			innerFuncDiags: diag.Diagnostics{
+				errs.NewErrorDiagnostic("Create error", "An error occurred"),
+			},
+			wantInFlight: 1,
+		},
+		"Read": {
+			why: Read,
+		},
+		"Delete": {
+			why:          Delete,
+			wantInFlight: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			semaphore := tfsync.NewSemaphore(2)
+			client := mockClient{
+				semaphores: map[string]tfsync.Semaphore{
+					typeName: semaphore,
+				},
+			}
+
+			interceptors := interceptorInvocations{
+				{
+					when:        Before | Finally,
+					why:         Create | Update | Delete,
+					interceptor: resourceConcurrencyLimit(typeName),
+				},
+			}
+
+			var inFlight int
+			f := func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				inFlight = len(semaphore)
+				return testCase.innerFuncDiags
+			}
+
+			handler := interceptedCRUDHandler(contextFunc, interceptors, f, testCase.why)
+
+			handler(t.Context(), nil, client)
+
+			if got, want := inFlight, testCase.wantInFlight; got != want {
+				t.Errorf("in-flight operations = %d, want %d", got, want)
+			}
+
+			if got, want := len(semaphore), 0; got != want {
+				t.Errorf("in-flight operations after handler = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestResourceConcurrencyLimitCancelled(t *testing.T) {
+	t.Parallel()
+
+	const typeName = "aws_test"
+
+	contextFunc := func(ctx context.Context, _ getAttributeFunc, _ getProviderMetaFunc, meta any) (context.Context, error) {
+		return ctx, nil
+	}
+
+	semaphore := tfsync.NewSemaphore(1)
+	semaphore.Wait()
+	client := mockClient{
+		semaphores: map[string]tfsync.Semaphore{
+			typeName: semaphore,
+		},
+	}
+
+	interceptors := interceptorInvocations{
+		{
+			when:        Before | Finally,
+			why:         Create | Update | Delete,
+			interceptor: resourceConcurrencyLimit(typeName),
+		},
+	}
+
+	f := newMockInnerCRUDFunc(nil)
+	handler := interceptedCRUDHandler(contextFunc, interceptors, f.Call, Create)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	diags := handler(ctx, nil, client)
+
+	if !diags.HasError() {
+		t.Error("expected error")
+	}
+
+	if f.count != 0 {
+		t.Errorf("expected inner function to not be called, got %d", f.count)
+	}
+
+	if got, want := len(semaphore), 1; got != want {
+		t.Errorf("in-flight operations after handler = %d, want %d", got, want)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -287,8 +288,10 @@ func regionalSingleParameterizedIdentitySpecNameMapped(identityAttrName, resourc
 }
 
 type mockClient struct {
-	accountID string
-	region    string
+	accountID  string
+	readOnly   bool
+	region     string
+	semaphores map[string]tfsync.Semaphore
 }
 
 func (c mockClient) AccountID(_ context.Context) string {
@@ -327,6 +330,15 @@ func (c mockClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) ReadOnly(context.Context) bool {
+	return c.readOnly
+}
+
+func (c mockClient) ResourceConcurrencySemaphore(_ context.Context, typeName string) (tfsync.Semaphore, bool) {
+	v, ok := c.semaphores[typeName]
+	return v, ok
+}
+
 func TestIdentityIsFullyNull(t *testing.T) {
 	t.Parallel()
 
@@ -6,10 +6,47 @@ package attribute
 import (
 	"sync"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+var AssumeRole = sync.OnceValue(func() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: names.ResourceTopLevelAssumeRoleAttributeDescription,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrExternalID: {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(2, 1224),
+						validation.StringMatch(regexache.MustCompile(`[\w+=,.@:\/\-]*`), ""),
+					),
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(2, 64),
+						validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
+					),
+				},
+			},
+		},
+	}
+})
+
 var Region = sync.OnceValue(func() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeString,
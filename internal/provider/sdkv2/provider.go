@@ -11,7 +11,9 @@ import (
 	"log"
 	"maps"
 	"os"
+	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -74,8 +76,19 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:      true,
 					ConflictsWith: []string{"forbidden_account_ids"},
 				},
+				"api_call_log": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path of a file to which a JSON line is appended for each AWS API call.",
+				},
 				"assume_role":                   assumeRoleSchema(),
 				"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+				"concurrency_limits": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeInt},
+					Description: "Map of resource type names, e.g. `aws_lambda_function`, to the maximum number of concurrent Create, Update and Delete operations.",
+				},
 				"custom_ca_bundle": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -97,6 +110,45 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 								Description: "Resource tags to default across all resources. " +
 									"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 							},
+							"rule": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Configuration blocks with resource tags to default across a subset of resources. Rules are merged in order over `tags`.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"exclude_resource_types": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource types, e.g. `aws_route53_record`, the rule does not apply to.",
+										},
+										"exclude_services": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Service packages, e.g. `iam`, the rule does not apply to.",
+										},
+										"include_resource_types": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource types, e.g. `aws_instance`, the rule applies to.",
+										},
+										"include_services": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Service packages, e.g. `ec2`, the rule applies to.",
+										},
+										"tags": {
+											Type:        schema.TypeMap,
+											Required:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource tags to default across the resources the rule applies to.",
+										},
+									},
+								},
+							},
 						},
 					},
 				},
@@ -152,6 +204,41 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 								Description: "Resource tag key prefixes to ignore across all resources. " +
 									"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 							},
+							"key_regexes": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringIsValidRegExp,
+								},
+								Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+							},
+							"key_suffixes": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Resource tag key suffixes to ignore across all resources.",
+							},
+							"value_pattern": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Configuration blocks with resource tags to ignore across all resources only when their values match a regular expression.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrKey: {
+											Type:        schema.TypeString,
+											Required:    true,
+											Description: "Resource tag key to ignore.",
+										},
+										"value_regex": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsValidRegExp,
+											Description:  "Regular expression matching the resource tag values to ignore.",
+										},
+									},
+								},
+							},
 						},
 					},
 				},
@@ -180,12 +267,83 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The profile for API operations. If not set, the default profile\n" +
 						"created with `aws configure` will be used.",
 				},
+				"rate_limit": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration blocks with client-side rate limits for AWS API requests.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"burst": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "The maximum number of requests that can be made at once. Defaults to `requests_per_second`, rounded up.",
+							},
+							"operation": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The API operation, e.g. `ChangeResourceRecordSets`, to limit. If not set, all of the service's operations are limited.",
+							},
+							"requests_per_second": {
+								Type:         schema.TypeFloat,
+								Required:     true,
+								ValidateFunc: validation.FloatAtLeast(0.01),
+								Description:  "The maximum rate of requests. The rate is reduced when throttling errors are returned.",
+							},
+							"service": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+								Description:  "The service, e.g. `route53`, to limit.",
+							},
+						},
+					},
+				},
+				"read_only": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Whether to refuse to create, update or delete resources and to call AWS API operations that modify resources. " +
+						"Can also be enabled using the `TF_AWS_READ_ONLY` environment variable.",
+				},
 				"region": {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: "The region where AWS operations will take place. Examples\n" +
 						"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 				},
+				"retry": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration blocks with per-service overrides of the retry behavior of AWS API requests.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_attempts": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "The maximum number of attempts, including the initial request, of an API call. Overrides `max_retries`.",
+							},
+							"max_backoff": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidDuration,
+								Description:  "The maximum delay between attempts. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							},
+							"retryable_error_codes": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Additional API error codes, e.g. `ConcurrentModificationException`, that are retried.",
+							},
+							"service": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+								Description:  "The service, e.g. `iam`, to configure.",
+							},
+						},
+					},
+				},
 				"retry_mode": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -260,7 +418,7 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-						`At this time this only includes compliance with required tag keys by resource type. ` +
+						`This includes compliance with required tag keys, tag key capitalization, and allowed tag values by resource type. ` +
 						`Valid values are "error", "warning", and "disabled". ` +
 						`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 						`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
@@ -367,6 +525,7 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		APICallLog:                     d.Get("api_call_log").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -504,10 +663,41 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	}
 	config.TagPolicyConfig = tagCfg
 
+	if v, ok := d.GetOk("concurrency_limits"); ok && len(v.(map[string]any)) > 0 {
+		limits, dg := expandConcurrencyLimits(cty.GetAttrPath("concurrency_limits"), v.(map[string]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.ConcurrencyLimits = limits
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limit"); ok && len(v.([]any)) > 0 {
+		config.RateLimits = expandRateLimits(v.([]any))
+	}
+
+	if v, ok := d.GetOk("read_only"); ok {
+		config.ReadOnly = v.(bool)
+	}
+	if v := os.Getenv(conns.ReadOnlyEnvVar); v != "" && !config.ReadOnly {
+		readOnly, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, append(diags, errs.NewErrorDiagnostic(
+				summaryInvalidEnvironmentVariableValue,
+				fmt.Sprintf("%s must be a boolean value", conns.ReadOnlyEnvVar),
+			))
+		}
+		config.ReadOnly = readOnly
+	}
+
+	if v, ok := d.GetOk("retry"); ok && len(v.([]any)) > 0 {
+		config.Retries = expandRetries(v.([]any))
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -690,6 +880,44 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 
 			var interceptors interceptorInvocations
 
+			// The read-only interceptor must be first.
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        Before,
+				why:         Create | Update | Delete,
+				interceptor: resourceReadOnly(typeName),
+			})
+
+			if resource.AssumeRole {
+				if _, ok := r.SchemaMap()["assume_role"]; ok {
+					errs = append(errs, fmt.Errorf("assume_role attribute is already defined: %s resource", typeName))
+					continue
+				}
+
+				// Inject a top-level "assume_role" attribute.
+				assumeRoleSchema := attribute.AssumeRole()
+
+				// If the resource defines no Update handler then add a stub to fake out 'Provider.Validate'.
+				if r.UpdateWithoutTimeout == nil {
+					r.UpdateWithoutTimeout = schema.NoopContext
+				}
+
+				if f := r.SchemaFunc; f != nil {
+					r.SchemaFunc = func() map[string]*schema.Schema {
+						s := f()
+						s["assume_role"] = assumeRoleSchema
+						return s
+					}
+				} else {
+					r.Schema["assume_role"] = assumeRoleSchema
+				}
+
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: forceNewIfAssumeRoleAccountChanges(),
+				})
+			}
+
 			if isRegionOverrideEnabled {
 				v := resource.Region.Value()
 				s := r.SchemaMap()
@@ -774,6 +1002,13 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				interceptors = append(interceptors, newIdentityInterceptor(&resource.Identity))
 			}
 
+			// The concurrency limit interceptor must be last.
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        Before | Finally,
+				why:         Create | Update | Delete,
+				interceptor: resourceConcurrencyLimit(typeName),
+			})
+
 			if resource.Import.CustomImport {
 				if r.Importer == nil || r.Importer.StateContext == nil {
 					errs = append(errs, fmt.Errorf("resource type %s: uses CustomImport but does not define an import function", typeName))
@@ -811,6 +1046,11 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					}
 
 					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, resource.TypeName, overrideRegion)
+					if resource.AssumeRole {
+						if v, ok := assumeRoleOverride(getAttribute); ok {
+							ctx = conns.WithOverrideAssumeRole(ctx, v)
+						}
+					}
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -1171,17 +1411,62 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 		maps.Copy(tags, cfgTags)
 	}
 
-	if len(tags) > 0 {
+	var rules []tftags.DefaultTagsRule
+	if v, ok := tfMap["rule"].([]any); ok {
+		rules = expandDefaultTagsRules(ctx, v)
+	}
+
+	if len(tags) > 0 || len(rules) > 0 {
 		return &tftags.DefaultConfig{
-			Tags: tftags.New(ctx, tags),
+			Tags:  tftags.New(ctx, tags),
+			Rules: rules,
 		}
 	}
 
 	return nil
 }
 
+func expandDefaultTagsRules(ctx context.Context, tfList []any) []tftags.DefaultTagsRule {
+	var apiObjects []tftags.DefaultTagsRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := tftags.DefaultTagsRule{}
+
+		if v, ok := tfMap["tags"].(map[string]any); ok {
+			apiObject.Tags = tftags.New(ctx, v)
+		}
+
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok {
+			apiObject.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["exclude_services"].(*schema.Set); ok {
+			apiObject.ExcludeServices = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["include_resource_types"].(*schema.Set); ok {
+			apiObject.IncludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["include_services"].(*schema.Set); ok {
+			apiObject.IncludeServices = flex.ExpandStringValueSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
-	var keys, keyPrefixes []any
+	var keys, keyPrefixes, keySuffixes []any
+	var keyRegexes []*regexp.Regexp
+	var valuePatterns []tftags.IgnoreValuePattern
 
 	if tfMap != nil {
 		if v, ok := tfMap["keys"].(*schema.Set); ok {
@@ -1190,6 +1475,17 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 		if v, ok := tfMap["key_prefixes"].(*schema.Set); ok {
 			keyPrefixes = v.List()
 		}
+		if v, ok := tfMap["key_suffixes"].(*schema.Set); ok {
+			keySuffixes = v.List()
+		}
+		if v, ok := tfMap["key_regexes"].(*schema.Set); ok {
+			for _, v := range flex.ExpandStringValueSet(v) {
+				keyRegexes = append(keyRegexes, regexache.MustCompile(v))
+			}
+		}
+		if v, ok := tfMap["value_pattern"].([]any); ok {
+			valuePatterns = expandIgnoreTagsValuePatterns(v)
+		}
 	}
 
 	if v := os.Getenv(tftags.IgnoreTagsKeysEnvVar); v != "" {
@@ -1210,24 +1506,139 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 
 	// To preseve behavior prior to supporting environment variables:
 	//
-	// - Return nil when no keys or prefixes are set
-	// - For a non-nil return, `keys` or `key_prefixes` should be
+	// - Return nil when no keys, prefixes, suffixes, regexes or value patterns are set
+	// - For a non-nil return, `keys`, `key_prefixes` or `key_suffixes` should be
 	//   nil if empty (versus a zero-value `KeyValueTags` struct)
-	if len(keys) == 0 && len(keyPrefixes) == 0 {
+	if len(keys) == 0 && len(keyPrefixes) == 0 && len(keySuffixes) == 0 && len(keyRegexes) == 0 && len(valuePatterns) == 0 {
 		return nil
 	}
 
-	ignoreConfig := &tftags.IgnoreConfig{}
+	ignoreConfig := &tftags.IgnoreConfig{
+		KeyRegexes:    keyRegexes,
+		ValuePatterns: valuePatterns,
+	}
 	if len(keys) > 0 {
 		ignoreConfig.Keys = tftags.New(ctx, keys)
 	}
 	if len(keyPrefixes) > 0 {
 		ignoreConfig.KeyPrefixes = tftags.New(ctx, keyPrefixes)
 	}
+	if len(keySuffixes) > 0 {
+		ignoreConfig.KeySuffixes = tftags.New(ctx, keySuffixes)
+	}
 
 	return ignoreConfig
 }
 
+func expandIgnoreTagsValuePatterns(tfList []any) []tftags.IgnoreValuePattern {
+	var apiObjects []tftags.IgnoreValuePattern
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := tftags.IgnoreValuePattern{}
+
+		if v, ok := tfMap[names.AttrKey].(string); ok {
+			apiObject.Key = v
+		}
+
+		if v, ok := tfMap["value_regex"].(string); ok {
+			apiObject.Value = regexache.MustCompile(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandConcurrencyLimits(path cty.Path, tfMap map[string]any) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	limits := make(map[string]int, len(tfMap))
+
+	for k, v := range tfMap {
+		limit := v.(int)
+		if limit < 1 {
+			diags = append(diags, errs.NewInvalidValueAttributeError(path.IndexString(k), "Must be at least 1"))
+			continue
+		}
+
+		limits[k] = limit
+	}
+
+	return limits, diags
+}
+
+func expandRateLimits(tfList []any) []conns.RateLimitConfig {
+	var apiObjects []conns.RateLimitConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := conns.RateLimitConfig{}
+
+		if v, ok := tfMap["burst"].(int); ok {
+			apiObject.Burst = v
+		}
+
+		if v, ok := tfMap["operation"].(string); ok {
+			apiObject.Operation = v
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			apiObject.RequestsPerSecond = v
+		}
+
+		if v, ok := tfMap["service"].(string); ok {
+			apiObject.Service = v
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandRetries(tfList []any) []conns.RetryConfig {
+	var apiObjects []conns.RetryConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := conns.RetryConfig{}
+
+		if v, ok := tfMap["max_attempts"].(int); ok {
+			apiObject.MaxAttempts = v
+		}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			duration, _ := time.ParseDuration(v)
+			apiObject.MaxBackoff = duration
+		}
+
+		if v, ok := tfMap["retryable_error_codes"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.RetryableErrorCodes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["service"].(string); ok {
+			apiObject.Service = v
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
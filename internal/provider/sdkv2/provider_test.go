@@ -5,13 +5,16 @@ package sdkv2
 
 import (
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -236,6 +239,7 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	ctx := t.Context()
 	testcases := map[string]struct {
 		tags                  map[string]any
+		rules                 []any
 		envvars               map[string]string
 		expectedDefaultConfig *tftags.DefaultConfig
 	}{
@@ -281,6 +285,31 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 				}),
 			},
 		},
+		"rules": {
+			tags: nil,
+			rules: []any{
+				map[string]any{
+					"tags": map[string]any{
+						"CostCenter": "100",
+					},
+					"include_services":       schema.NewSet(schema.HashString, []any{"ec2"}),
+					"exclude_resource_types": schema.NewSet(schema.HashString, []any{"aws_ec2_tag"}),
+				},
+			},
+			envvars: map[string]string{},
+			expectedDefaultConfig: &tftags.DefaultConfig{
+				Tags: tftags.New(ctx, map[string]string{}),
+				Rules: []tftags.DefaultTagsRule{
+					{
+						Tags: tftags.New(ctx, map[string]string{
+							"CostCenter": "100",
+						}),
+						IncludeServices:      []string{"ec2"},
+						ExcludeResourceTypes: []string{"aws_ec2_tag"},
+					},
+				},
+			},
+		},
 	}
 
 	for name, testcase := range testcases { //nolint:paralleltest
@@ -294,6 +323,7 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 
 			results := expandDefaultTags(ctx, map[string]any{
 				"tags": testcase.tags,
+				"rule": testcase.rules,
 			})
 
 			if results == nil {
@@ -304,6 +334,10 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 				}
 			} else if !testcase.expectedDefaultConfig.TagsEqual(results.Tags) {
 				t.Errorf("Expected default tags config to be %v, got %v", testcase.expectedDefaultConfig, results)
+			} else if diff := cmp.Diff(results.Rules, testcase.expectedDefaultConfig.Rules, cmp.Transformer("KeyValueTags", func(tags tftags.KeyValueTags) map[string]string {
+				return tags.Map()
+			})); diff != "" {
+				t.Errorf("unexpected default tags rules diff (+wanted, -got): %s", diff)
 			}
 		})
 	}
@@ -314,6 +348,9 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	testcases := map[string]struct {
 		keys                 []any
 		keyPrefixes          []any
+		keySuffixes          []any
+		keyRegexes           []any
+		valuePatterns        []any
 		envvars              map[string]string
 		expectedIgnoreConfig *tftags.IgnoreConfig
 	}{
@@ -398,6 +435,27 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 				KeyPrefixes: tftags.New(ctx, []any{"example1", "example2", "example3"}),
 			},
 		},
+		"config key_suffixes key_regexes and value_pattern": {
+			keySuffixes: []any{"config1"},
+			keyRegexes:  []any{"^config2"},
+			valuePatterns: []any{
+				map[string]any{
+					names.AttrKey: "config3",
+					"value_regex": `^\d+$`,
+				},
+			},
+			envvars: map[string]string{},
+			expectedIgnoreConfig: &tftags.IgnoreConfig{
+				KeySuffixes: tftags.New(ctx, []any{"config1"}),
+				KeyRegexes:  []*regexp.Regexp{regexache.MustCompile("^config2")},
+				ValuePatterns: []tftags.IgnoreValuePattern{
+					{
+						Key:   "config3",
+						Value: regexache.MustCompile(`^\d+$`),
+					},
+				},
+			},
+		},
 	}
 
 	for name, testcase := range testcases { //nolint:paralleltest
@@ -410,21 +468,74 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 			}
 
 			results := expandIgnoreTags(ctx, map[string]any{
-				"keys":         schema.NewSet(schema.HashString, testcase.keys),
-				"key_prefixes": schema.NewSet(schema.HashString, testcase.keyPrefixes),
+				"keys":          schema.NewSet(schema.HashString, testcase.keys),
+				"key_prefixes":  schema.NewSet(schema.HashString, testcase.keyPrefixes),
+				"key_suffixes":  schema.NewSet(schema.HashString, testcase.keySuffixes),
+				"key_regexes":   schema.NewSet(schema.HashString, testcase.keyRegexes),
+				"value_pattern": testcase.valuePatterns,
 			})
 
 			if results == nil && testcase.expectedIgnoreConfig != nil {
 				t.Errorf("Expected ignore tags config to be %v, got nil", testcase.expectedIgnoreConfig)
 			}
 
-			if diff := cmp.Diff(testcase.expectedIgnoreConfig, results); diff != "" {
+			if diff := cmp.Diff(testcase.expectedIgnoreConfig, results, cmp.Comparer(func(x, y *regexp.Regexp) bool {
+				return x.String() == y.String()
+			})); diff != "" {
 				t.Errorf("Unexpected ignore_tags diff: %s", diff)
 			}
 		})
 	}
 }
 
+func TestExpandConcurrencyLimits(t *testing.T) {
+	t.Parallel()
+
+	path := cty.GetAttrPath("concurrency_limits")
+
+	testcases := map[string]struct {
+		tfMap          map[string]any
+		expectedLimits map[string]int
+		expectedDiags  diag.Diagnostics
+	}{
+		"valid": {
+			tfMap: map[string]any{
+				"aws_cloudformation_stack": 2,
+				"aws_lambda_function":      10,
+			},
+			expectedLimits: map[string]int{
+				"aws_cloudformation_stack": 2,
+				"aws_lambda_function":      10,
+			},
+		},
+		"invalid": {
+			tfMap: map[string]any{
+				"aws_lambda_function": 0,
+			},
+			expectedLimits: map[string]int{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeError(path.IndexString("aws_lambda_function"), "Must be at least 1"),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			limits, diags := expandConcurrencyLimits(path, testcase.tfMap)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(limits, testcase.expectedLimits); diff != "" {
+				t.Errorf("unexpected limits difference: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
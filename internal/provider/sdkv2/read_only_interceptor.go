@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// resourceReadOnly refuses Create, Update and Delete operations when the provider is in read-only mode.
+func resourceReadOnly(typeName string) crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		c := opts.c
+		var diags diag.Diagnostics
+
+		if !c.ReadOnly(ctx) {
+			return diags
+		}
+
+		switch when, why := opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Create, Update, Delete:
+				return append(diags, errs.NewErrorDiagnostic(
+					"Provider in read-only mode",
+					fmt.Sprintf("%s resources can't be created, updated or deleted as the provider is in read-only mode. "+
+						"Read-only mode is enabled by the provider's read_only argument or the TF_AWS_READ_ONLY environment variable.", typeName),
+				))
+			}
+		}
+
+		return diags
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+)
+
+func TestResourceReadOnly(t *testing.T) {
+	t.Parallel()
+
+	contextFunc := func(ctx context.Context, _ getAttributeFunc, _ getProviderMetaFunc, meta any) (context.Context, error) {
+		return ctx, nil
+	}
+
+	testCases := map[string]struct {
+		readOnly           bool
+		why                why
+		expectedError      bool
+		expectedInnerCalls int
+	}{
+		"Create": {
+			why:                Create,
+			expectedInnerCalls: 1,
+		},
+		"read-only Create": {
+			readOnly:      true,
+			why:           Create,
+			expectedError: true,
+		},
+		"read-only Read": {
+			readOnly:           true,
+			why:                Read,
+			expectedInnerCalls: 1,
+		},
+		"read-only Delete": {
+			readOnly:      true,
+			why:           Delete,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := mockClient{
+				readOnly: testCase.readOnly,
+			}
+
+			interceptors := interceptorInvocations{
+				{
+					when:        Before,
+					why:         Create | Update | Delete,
+					interceptor: resourceReadOnly("aws_test"),
+				},
+			}
+
+			f := newMockInnerCRUDFunc(nil)
+			handler := interceptedCRUDHandler(contextFunc, interceptors, f.Call, testCase.why)
+
+			diags := handler(t.Context(), nil, client)
+
+			if got, want := diags.HasError(), testCase.expectedError; got != want {
+				t.Errorf("HasError() = %v, want %v", got, want)
+			}
+
+			if got, want := f.count, testCase.expectedInnerCalls; got != want {
+				t.Errorf("inner function calls = %d, want %d", got, want)
+			}
+		})
+	}
+}
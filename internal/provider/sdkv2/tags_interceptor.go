@@ -5,8 +5,10 @@ package sdkv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -313,8 +315,9 @@ func validateRequiredTags() customizeDiffInterceptor {
 		if policy == nil {
 			return nil
 		}
-		reqTags, ok := policy.RequiredTags[typeName]
-		if !ok {
+		reqTags, hasReqTags := policy.RequiredTags[typeName]
+		_, hasRules := policy.Rules[typeName]
+		if !hasReqTags && !hasRules {
 			return nil
 		}
 
@@ -335,27 +338,35 @@ func validateRequiredTags() customizeDiffInterceptor {
 
 				cfgTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
 				allTags := c.DefaultTagsConfig(ctx).MergeTags(cfgTags)
-				if allTags.ContainsAllKeys(reqTags) {
-					return nil
+
+				var errs []error
+				report := func(summary, detail string) {
+					// CustomizeDiff does not support diagnostics (only an error return)
+					switch policy.Severity {
+					case "warning":
+						// Warning diagnostics are only logged
+						tflog.Warn(ctx, "Tag Policy Validation", map[string]any{
+							"summary": summary,
+							"detail":  detail,
+						})
+					default:
+						// Error diagnostics merge summary and detail into a single message
+						errs = append(errs, fmt.Errorf("%s - %s", summary, detail))
+					}
 				}
 
-				missing := reqTags.Removed(allTags).Keys()
-				slices.Sort(missing)
-				summary := "Missing Required Tags"
-				detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
-
-				// CustomizeDiff does not support diagnostics (only an error return)
-				switch policy.Severity {
-				case "warning":
-					// Warning diagnostics are only logged
-					tflog.Warn(ctx, "Required Tags Validation", map[string]any{
-						"summary": summary,
-						"detail":  detail,
-					})
-				default:
-					// Error diagnostics merge summary and detail into a single message
-					return fmt.Errorf("%s - %s", summary, detail)
+				if !allTags.ContainsAllKeys(reqTags) {
+					missing := reqTags.Removed(allTags).Keys()
+					slices.Sort(missing)
+
+					report("Missing Required Tags", fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing))
+				}
+
+				if nonCompliant := policy.NonCompliant(typeName, allTags); len(nonCompliant) > 0 {
+					report("Non-Compliant Tags", fmt.Sprintf("An organizational tag policy does not allow the following tags for %s: %s", typeName, strings.Join(nonCompliant, ", ")))
 				}
+
+				return errors.Join(errs...)
 			}
 		}
 
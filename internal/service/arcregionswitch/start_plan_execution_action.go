@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arcregionswitch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/arcregionswitch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arcregionswitch/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_arcregionswitch_start_plan_execution, name="Start Plan Execution")
+func newStartPlanExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startPlanExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startPlanExecutionAction)(nil)
+)
+
+type startPlanExecutionAction struct {
+	framework.ActionWithModel[startPlanExecutionActionModel]
+}
+
+type startPlanExecutionActionModel struct {
+	Action        fwtypes.StringEnum[awstypes.ExecutionAction] `tfsdk:"action"`
+	Comment       types.String                                 `tfsdk:"comment"`
+	LatestVersion types.String                                 `tfsdk:"latest_version"`
+	Mode          fwtypes.StringEnum[awstypes.ExecutionMode]   `tfsdk:"mode"`
+	PlanARN       fwtypes.ARN                                  `tfsdk:"plan_arn"`
+	TargetRegion  types.String                                 `tfsdk:"target_region"`
+	Timeout       types.Int64                                  `tfsdk:"timeout"`
+}
+
+func (a *startPlanExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an execution of an ARC Region switch plan and waits for the execution to complete.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrAction: schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.ExecutionAction](),
+				Description: "The action to perform on the target Region: activate or deactivate",
+				Required:    true,
+			},
+			names.AttrComment: schema.StringAttribute{
+				Description: "A comment describing why the plan is being executed",
+				Optional:    true,
+			},
+			"latest_version": schema.StringAttribute{
+				Description: "The plan version the execution is expected to run. The execution fails to start if the plan has since been updated",
+				Optional:    true,
+			},
+			names.AttrMode: schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.ExecutionMode](),
+				Description: "The execution mode: graceful or ungraceful (default: graceful)",
+				Optional:    true,
+			},
+			"plan_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the plan to execute",
+				Required:    true,
+			},
+			"target_region": schema.StringAttribute{
+				Description: "The Region to activate or deactivate",
+				Required:    true,
+				Validators: []validator.String{
+					fwvalidators.AWSRegion(),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the plan execution to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *startPlanExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startPlanExecutionActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ARCRegionSwitchClient(ctx)
+
+	planARN := fwflex.StringValueFromFramework(ctx, config.PlanARN)
+	targetRegion := fwflex.StringValueFromFramework(ctx, config.TargetRegion)
+	executionAction := config.Action.ValueEnum()
+	executionMode := awstypes.ExecutionModeGraceful
+	if !config.Mode.IsNull() && !config.Mode.IsUnknown() {
+		executionMode = config.Mode.ValueEnum()
+	}
+	timeout := fwactions.TimeoutOr(config.Timeout, 3600*time.Second)
+
+	tflog.Info(ctx, "Starting ARC Region switch start plan execution action", map[string]any{
+		"plan_arn":        planARN,
+		"target_region":   targetRegion,
+		names.AttrAction:  executionAction,
+		names.AttrMode:    executionMode,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting %s %s execution of ARC Region switch plan %s for Region %s...", executionMode, executionAction, planARN, targetRegion)
+
+	input := arcregionswitch.StartPlanExecutionInput{
+		Action:        executionAction,
+		Comment:       fwflex.StringFromFramework(ctx, config.Comment),
+		LatestVersion: fwflex.StringFromFramework(ctx, config.LatestVersion),
+		Mode:          executionMode,
+		PlanArn:       aws.String(planARN),
+		TargetRegion:  aws.String(targetRegion),
+	}
+
+	output, err := conn.StartPlanExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("starting ARC Region switch Plan (%s) execution", planARN), err.Error())
+		return
+	}
+
+	executionID := aws.ToString(output.ExecutionId)
+
+	cb(ctx, "Plan execution %s started, waiting for it to complete...", executionID)
+
+	// Step statuses already reported, keyed by step name, so that only changes are streamed.
+	reported := make(map[string]awstypes.StepStatus)
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*arcregionswitch.GetPlanExecutionOutput], error) {
+		output, err := findPlanExecutionByTwoPartKey(ctx, conn, planARN, executionID)
+		if err != nil {
+			return actionwait.FetchResult[*arcregionswitch.GetPlanExecutionOutput]{}, err
+		}
+
+		for _, step := range output.StepStates {
+			name := aws.ToString(step.Name)
+			if v, ok := reported[name]; ok && v == step.Status {
+				continue
+			}
+			reported[name] = step.Status
+			cb(ctx, "Plan execution %s step %q is %s", executionID, name, step.Status)
+		}
+
+		return actionwait.FetchResult[*arcregionswitch.GetPlanExecutionOutput]{Status: actionwait.Status(output.ExecutionState), Value: output}, nil
+	}, actionwait.Options[*arcregionswitch.GetPlanExecutionOutput]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: 60 * time.Second,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExecutionStateCompleted),
+			actionwait.Status(awstypes.ExecutionStateCompletedWithExceptions),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExecutionStatePending),
+			actionwait.Status(awstypes.ExecutionStateInProgress),
+			actionwait.Status(awstypes.ExecutionStatePendingManualApproval),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExecutionStateFailed),
+			actionwait.Status(awstypes.ExecutionStateCanceled),
+			actionwait.Status(awstypes.ExecutionStatePausedByFailedStep),
+			actionwait.Status(awstypes.ExecutionStatePausedByOperator),
+			actionwait.Status(awstypes.ExecutionStatePlanExecutionTimedOut),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Plan execution %s currently in state: %s", executionID, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Plan Execution",
+				fmt.Sprintf("Execution %s of ARC Region switch plan %s did not complete within %s: %s", executionID, planARN, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Plan Execution Failed",
+				fmt.Sprintf("Execution %s of ARC Region switch plan %s completed with status %s", executionID, planARN, failureErr.Status),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Plan Execution Status",
+				fmt.Sprintf("Execution %s of ARC Region switch plan %s entered unexpected state: %s", executionID, planARN, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Plan Execution",
+				fmt.Sprintf("Waiting for execution %s of ARC Region switch plan %s: %s", executionID, planARN, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Plan execution %s completed successfully", executionID)
+
+	tflog.Info(ctx, "ARC Region switch start plan execution action completed successfully", map[string]any{
+		"execution_id": executionID,
+		"plan_arn":     planARN,
+	})
+}
+
+// findPlanExecutionByTwoPartKey returns the plan execution with the step states from all pages.
+func findPlanExecutionByTwoPartKey(ctx context.Context, conn *arcregionswitch.Client, planARN, executionID string) (*arcregionswitch.GetPlanExecutionOutput, error) {
+	input := arcregionswitch.GetPlanExecutionInput{
+		ExecutionId: aws.String(executionID),
+		PlanArn:     aws.String(planARN),
+	}
+
+	var output *arcregionswitch.GetPlanExecutionOutput
+	for {
+		page, err := conn.GetPlanExecution(ctx, &input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			return nil, tfresource.NewEmptyResultError()
+		}
+
+		if output == nil {
+			output = page
+		} else {
+			output.StepStates = append(output.StepStates, page.StepStates...)
+		}
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
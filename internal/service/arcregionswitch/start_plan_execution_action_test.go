@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arcregionswitch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/arcregionswitch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arcregionswitch/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccARCRegionSwitchStartPlanExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Plan
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCRegionSwitch),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckPlanDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				// The action fails the apply if the plan execution does not complete.
+				Config: testAccStartPlanExecutionActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(ctx, t, "aws_arcregionswitch_plan.test", &v),
+					testAccCheckPlanExecutionCompleted(ctx, t, &v),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckPlanExecutionCompleted verifies that the action was invoked and ran an execution of the plan to completion.
+func testAccCheckPlanExecutionCompleted(ctx context.Context, t *testing.T, v *awstypes.Plan) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ARCRegionSwitchClient(ctx)
+
+		input := arcregionswitch.ListPlanExecutionsInput{
+			PlanArn: v.Arn,
+			State:   awstypes.ExecutionStateCompleted,
+		}
+
+		pages := arcregionswitch.NewListPlanExecutionsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			if len(page.Items) > 0 {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("ARC Region Switch Plan (%s) has no completed executions", aws.ToString(v.Arn))
+	}
+}
+
+func testAccStartPlanExecutionActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action = "sts:AssumeRole"
+        Effect = "Allow"
+        Principal = {
+          Service = "lambda.amazonaws.com"
+        }
+      },
+    ]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename         = "test-fixtures/lambdatest.zip"
+  source_code_hash = filebase64sha256("test-fixtures/lambdatest.zip")
+  function_name    = %[1]q
+  role             = aws_iam_role.lambda.arn
+  handler          = "exports.example"
+  runtime          = "nodejs20.x"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action = "sts:AssumeRole"
+        Effect = "Allow"
+        Principal = {
+          Service = "arc-region-switch.amazonaws.com"
+        }
+      },
+    ]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action   = ["lambda:GetFunction", "lambda:InvokeFunction"]
+        Effect   = "Allow"
+        Resource = aws_lambda_function.test.arn
+      },
+    ]
+  })
+}
+
+resource "aws_arcregionswitch_plan" "test" {
+  name              = %[1]q
+  execution_role    = aws_iam_role.test.arn
+  recovery_approach = "activePassive"
+  regions           = [%[2]q, %[3]q]
+  primary_region    = %[2]q
+
+  workflow {
+    workflow_target_action = "activate"
+    workflow_target_region = %[2]q
+
+    step {
+      name                 = "lambda-step"
+      execution_block_type = "CustomActionLambda"
+
+      custom_action_lambda_config {
+        region_to_run          = "activatingRegion"
+        retry_interval_minutes = 1
+        timeout_minutes        = 5
+
+        lambda {
+          arn = aws_lambda_function.test.arn
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+action "aws_arcregionswitch_start_plan_execution" "test" {
+  config {
+    plan_arn      = aws_arcregionswitch_plan.test.arn
+    target_region = %[2]q
+    action        = "activate"
+    comment       = "Terraform acceptance test"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_arcregionswitch_plan.test.arn
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_arcregionswitch_start_plan_execution.test]
+    }
+  }
+}
+`, rName, acctest.Region(), acctest.AlternateRegion())
+}
@@ -15,6 +15,8 @@ var (
 	ResourceSchedule                = resourceSchedule
 	ResourceTrafficSourceAttachment = resourceTrafficSourceAttachment
 
+	DesiredConfigurationFromGroup = desiredConfigurationFromGroup
+
 	FindAttachmentByLoadBalancerName          = findAttachmentByLoadBalancerName
 	FindAttachmentByTargetGroupARN            = findAttachmentByTargetGroupARN
 	FindInstanceRefreshes                     = findInstanceRefreshes
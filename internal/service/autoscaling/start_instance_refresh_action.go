@@ -0,0 +1,342 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_autoscaling_start_instance_refresh, name="Start Instance Refresh")
+func newStartInstanceRefreshAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startInstanceRefreshAction{}, nil
+}
+
+var (
+	_ action.Action = (*startInstanceRefreshAction)(nil)
+)
+
+type startInstanceRefreshAction struct {
+	framework.ActionWithModel[startInstanceRefreshActionModel]
+}
+
+type startInstanceRefreshActionModel struct {
+	framework.WithRegionModel
+	AutoScalingGroupName types.String                                                   `tfsdk:"autoscaling_group_name"`
+	Preferences          fwtypes.ListNestedObjectValueOf[refreshPreferencesActionModel] `tfsdk:"preferences"`
+	Timeout              types.Int64                                                    `tfsdk:"timeout"`
+}
+
+type refreshPreferencesActionModel struct {
+	AutoRollback          types.Bool          `tfsdk:"auto_rollback"`
+	CheckpointDelay       types.Int64         `tfsdk:"checkpoint_delay"`
+	CheckpointPercentages fwtypes.ListOfInt64 `tfsdk:"checkpoint_percentages"`
+	InstanceWarmup        types.Int64         `tfsdk:"instance_warmup"`
+	MaxHealthyPercentage  types.Int64         `tfsdk:"max_healthy_percentage"`
+	MinHealthyPercentage  types.Int64         `tfsdk:"min_healthy_percentage"`
+	SkipMatching          types.Bool          `tfsdk:"skip_matching"`
+}
+
+func (a *startInstanceRefreshAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an instance refresh of an Auto Scaling group and waits for it to complete, reporting the percentage complete and checkpoint status.",
+		Attributes: map[string]schema.Attribute{
+			"autoscaling_group_name": schema.StringAttribute{
+				Description: "The name of the Auto Scaling group",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the instance refresh to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(172800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"preferences": schema.ListNestedBlock{
+				Description: "Preferences for the instance refresh",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[refreshPreferencesActionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auto_rollback": schema.BoolAttribute{
+							Description: "Whether to roll back the Auto Scaling group to its previous configuration if the instance refresh fails",
+							Optional:    true,
+						},
+						"checkpoint_delay": schema.Int64Attribute{
+							Description: "Number of seconds to wait after a checkpoint is reached",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 172800),
+							},
+						},
+						"checkpoint_percentages": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfInt64Type,
+							Description: "Percentages of the instance refresh at which to pause for checkpoint_delay seconds. The last value must be 100",
+							Optional:    true,
+							ElementType: types.Int64Type,
+							Validators: []validator.List{
+								listvalidator.ValueInt64sAre(int64validator.Between(1, 100)),
+							},
+						},
+						"instance_warmup": schema.Int64Attribute{
+							Description: "Number of seconds until a newly launched instance is configured and ready to use",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"max_healthy_percentage": schema.Int64Attribute{
+							Description: "Maximum percentage of the group's capacity that can be in service and healthy, or pending, during the instance refresh",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(100, 200),
+							},
+						},
+						"min_healthy_percentage": schema.Int64Attribute{
+							Description: "Minimum percentage of the group's capacity that must remain in service and healthy during the instance refresh",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 100),
+							},
+						},
+						"skip_matching": schema.BoolAttribute{
+							Description: "Whether to skip replacing instances that already match the group's desired configuration",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *startInstanceRefreshAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startInstanceRefreshActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().AutoScalingClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, config.AutoScalingGroupName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 3600*time.Second)
+
+	var input autoscaling.StartInstanceRefreshInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// "The AutoRollback parameter cannot be set to true when the DesiredConfiguration parameter is empty".
+	// Roll back to the group's current configuration.
+	if input.Preferences != nil && aws.ToBool(input.Preferences.AutoRollback) {
+		group, err := findGroupByName(ctx, conn, name)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("reading Auto Scaling Group (%s)", name), err.Error())
+			return
+		}
+
+		desiredConfiguration, err := desiredConfigurationFromGroup(group)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("starting Auto Scaling Group (%s) instance refresh", name), err.Error())
+			return
+		}
+
+		input.DesiredConfiguration = desiredConfiguration
+	}
+
+	tflog.Info(ctx, "Starting Auto Scaling start instance refresh action", map[string]any{
+		"autoscaling_group_name": name,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting instance refresh of Auto Scaling group %s...", name)
+
+	output, err := conn.StartInstanceRefresh(ctx, &input)
+	if errs.IsA[*awstypes.InstanceRefreshInProgressFault](err) {
+		resp.Diagnostics.AddError(
+			"Instance Refresh In Progress",
+			fmt.Sprintf("An instance refresh of Auto Scaling group %s is already in progress", name),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("starting Auto Scaling Group (%s) instance refresh", name), err.Error())
+		return
+	}
+
+	id := aws.ToString(output.InstanceRefreshId)
+	cb(ctx, "Instance refresh %s started, waiting for completion...", id)
+
+	// Report the percentage complete and checkpoint status as they change.
+	var lastReported string
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.InstanceRefresh], error) {
+		input := autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: aws.String(name),
+			InstanceRefreshIds:   []string{id},
+		}
+		refresh, err := findInstanceRefresh(ctx, conn, &input)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.InstanceRefresh]{}, err
+		}
+
+		if progress := instanceRefreshProgress(refresh); progress != lastReported {
+			lastReported = progress
+			cb(ctx, "%s", progress)
+		}
+
+		return actionwait.FetchResult[*awstypes.InstanceRefresh]{Status: actionwait.Status(refresh.Status), Value: refresh}, nil
+	}, actionwait.Options[*awstypes.InstanceRefresh]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: 60 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.InstanceRefreshStatusSuccessful)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusPending),
+			actionwait.Status(awstypes.InstanceRefreshStatusInProgress),
+			actionwait.Status(awstypes.InstanceRefreshStatusBaking),
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelling),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusFailed),
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelled),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackFailed),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackSuccessful),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Instance refresh currently in state: %s", fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Instance Refresh",
+				fmt.Sprintf("Instance refresh %s of Auto Scaling group %s did not complete within %s: %s", id, name, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Instance Refresh Failed",
+				fmt.Sprintf("Instance refresh %s of Auto Scaling group %s completed with status %s: %s", id, name, failureErr.Status, aws.ToString(result.Value.StatusReason)),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Instance Refresh Status",
+				fmt.Sprintf("Instance refresh %s of Auto Scaling group %s entered unexpected state: %s", id, name, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Instance Refresh",
+				fmt.Sprintf("Error while waiting for instance refresh %s of Auto Scaling group %s: %s", id, name, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Instance refresh %s of Auto Scaling group %s completed successfully", id, name)
+
+	tflog.Info(ctx, "Auto Scaling start instance refresh action completed successfully", map[string]any{
+		"autoscaling_group_name": name,
+		"instance_refresh_id":    id,
+	})
+}
+
+// desiredConfigurationFromGroup returns a desired configuration matching the group's current launch template
+// or mixed instances policy.
+func desiredConfigurationFromGroup(group *awstypes.AutoScalingGroup) (*awstypes.DesiredConfiguration, error) {
+	if aws.ToString(group.LaunchConfigurationName) != "" {
+		return nil, errors.New("auto_rollback is not supported for Auto Scaling groups that use a launch configuration")
+	}
+
+	desiredConfiguration := &awstypes.DesiredConfiguration{
+		LaunchTemplate: launchTemplateSpecificationForRequest(group.LaunchTemplate),
+	}
+
+	if v := group.MixedInstancesPolicy; v != nil {
+		mixedInstancesPolicy := *v
+		if v := mixedInstancesPolicy.LaunchTemplate; v != nil {
+			launchTemplate := *v
+			launchTemplate.LaunchTemplateSpecification = launchTemplateSpecificationForRequest(launchTemplate.LaunchTemplateSpecification)
+			launchTemplate.Overrides = make([]awstypes.LaunchTemplateOverrides, len(v.Overrides))
+			for i, override := range v.Overrides {
+				override.LaunchTemplateSpecification = launchTemplateSpecificationForRequest(override.LaunchTemplateSpecification)
+				launchTemplate.Overrides[i] = override
+			}
+			mixedInstancesPolicy.LaunchTemplate = &launchTemplate
+		}
+		desiredConfiguration.MixedInstancesPolicy = &mixedInstancesPolicy
+	}
+
+	if desiredConfiguration.LaunchTemplate == nil && desiredConfiguration.MixedInstancesPolicy == nil {
+		return nil, errors.New("auto_rollback requires an Auto Scaling group that uses a launch template or mixed instances policy")
+	}
+
+	return desiredConfiguration, nil
+}
+
+// launchTemplateSpecificationForRequest returns a copy of a launch template specification read from
+// DescribeAutoScalingGroups that is valid in a request.
+// DescribeAutoScalingGroups returns both name and id but LaunchTemplateSpecification
+// allows only one of them to be set. Prefer the ID.
+func launchTemplateSpecificationForRequest(apiObject *awstypes.LaunchTemplateSpecification) *awstypes.LaunchTemplateSpecification {
+	if apiObject == nil {
+		return nil
+	}
+
+	result := &awstypes.LaunchTemplateSpecification{
+		Version: apiObject.Version,
+	}
+
+	if v := aws.ToString(apiObject.LaunchTemplateId); v != "" {
+		result.LaunchTemplateId = aws.String(v)
+	} else {
+		result.LaunchTemplateName = apiObject.LaunchTemplateName
+	}
+
+	return result
+}
+
+// instanceRefreshProgress returns a human-readable summary of an instance refresh's progress.
+func instanceRefreshProgress(refresh *awstypes.InstanceRefresh) string {
+	progress := fmt.Sprintf("Instance refresh %s: %d%% complete, %d instances to update", refresh.Status, aws.ToInt32(refresh.PercentageComplete), aws.ToInt32(refresh.InstancesToUpdate))
+
+	// The status reason describes checkpoint waits, for example "Waiting for checkpoint delay of 600 seconds".
+	if v := aws.ToString(refresh.StatusReason); v != "" {
+		progress += " (" + v + ")"
+	}
+
+	return progress
+}
@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDesiredConfigurationFromGroup(t *testing.T) {
+	t.Parallel()
+
+	launchTemplate := func() *awstypes.LaunchTemplateSpecification {
+		return &awstypes.LaunchTemplateSpecification{
+			LaunchTemplateId:   aws.String("lt-12345678"),
+			LaunchTemplateName: aws.String("example"),
+			Version:            aws.String("$Latest"),
+		}
+	}
+	want := &awstypes.LaunchTemplateSpecification{
+		LaunchTemplateId: aws.String("lt-12345678"),
+		Version:          aws.String("$Latest"),
+	}
+
+	testCases := map[string]struct {
+		group         *awstypes.AutoScalingGroup
+		expected      *awstypes.DesiredConfiguration
+		expectedError bool
+	}{
+		"launch template": {
+			group: &awstypes.AutoScalingGroup{
+				LaunchTemplate: launchTemplate(),
+			},
+			expected: &awstypes.DesiredConfiguration{
+				LaunchTemplate: want,
+			},
+		},
+		"launch template name only": {
+			group: &awstypes.AutoScalingGroup{
+				LaunchTemplate: &awstypes.LaunchTemplateSpecification{
+					LaunchTemplateName: aws.String("example"),
+					Version:            aws.String("1"),
+				},
+			},
+			expected: &awstypes.DesiredConfiguration{
+				LaunchTemplate: &awstypes.LaunchTemplateSpecification{
+					LaunchTemplateName: aws.String("example"),
+					Version:            aws.String("1"),
+				},
+			},
+		},
+		"mixed instances policy": {
+			group: &awstypes.AutoScalingGroup{
+				MixedInstancesPolicy: &awstypes.MixedInstancesPolicy{
+					LaunchTemplate: &awstypes.LaunchTemplate{
+						LaunchTemplateSpecification: launchTemplate(),
+						Overrides: []awstypes.LaunchTemplateOverrides{
+							{
+								InstanceType:                aws.String("t3.micro"),
+								LaunchTemplateSpecification: launchTemplate(),
+							},
+							{
+								InstanceType: aws.String("t3.small"),
+							},
+						},
+					},
+				},
+			},
+			expected: &awstypes.DesiredConfiguration{
+				MixedInstancesPolicy: &awstypes.MixedInstancesPolicy{
+					LaunchTemplate: &awstypes.LaunchTemplate{
+						LaunchTemplateSpecification: want,
+						Overrides: []awstypes.LaunchTemplateOverrides{
+							{
+								InstanceType:                aws.String("t3.micro"),
+								LaunchTemplateSpecification: want,
+							},
+							{
+								InstanceType: aws.String("t3.small"),
+							},
+						},
+					},
+				},
+			},
+		},
+		"launch configuration": {
+			group: &awstypes.AutoScalingGroup{
+				LaunchConfigurationName: aws.String("example"),
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfautoscaling.DesiredConfigurationFromGroup(testCase.group)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("DesiredConfigurationFromGroup() err %t, want %t: %v", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreUnexported(awstypes.DesiredConfiguration{}, awstypes.LaunchTemplateSpecification{}, awstypes.MixedInstancesPolicy{}, awstypes.LaunchTemplate{}, awstypes.LaunchTemplateOverrides{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccAutoScalingStartInstanceRefreshAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartInstanceRefreshActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStartInstanceRefreshActionSucceeded(ctx, t, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingStartInstanceRefreshAction_preferences(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartInstanceRefreshActionConfig_preferences(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStartInstanceRefreshActionSucceeded(ctx, t, rName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckStartInstanceRefreshActionSucceeded verifies that the group's most recent instance refresh succeeded.
+func testAccCheckStartInstanceRefreshActionSucceeded(ctx context.Context, t *testing.T, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).AutoScalingClient(ctx)
+
+		input := &autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: &name,
+		}
+
+		output, err := conn.DescribeInstanceRefreshes(ctx, input)
+		if err != nil {
+			return fmt.Errorf("Failed to describe instance refreshes for Auto Scaling group %s: %w", name, err)
+		}
+
+		// Instance refreshes are returned in reverse chronological order.
+		if len(output.InstanceRefreshes) == 0 {
+			return fmt.Errorf("No instance refreshes found for Auto Scaling group %s", name)
+		}
+
+		if got, want := output.InstanceRefreshes[0].Status, awstypes.InstanceRefreshStatusSuccessful; got != want {
+			return fmt.Errorf("Auto Scaling group %s instance refresh status = %s, want %s", name, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartInstanceRefreshActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccGroupConfig_launchTemplateBase(rName, "t3.nano"), fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones = [data.aws_availability_zones.available.names[0]]
+  name               = %[1]q
+  max_size           = 2
+  min_size           = 1
+  desired_capacity   = 1
+
+  launch_template {
+    id      = aws_launch_template.test.id
+    version = aws_launch_template.test.default_version
+  }
+
+  tag {
+    key                 = "Name"
+    value               = %[1]q
+    propagate_at_launch = true
+  }
+}
+`, rName))
+}
+
+func testAccStartInstanceRefreshActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStartInstanceRefreshActionConfig_base(rName), `
+action "aws_autoscaling_start_instance_refresh" "test" {
+  config {
+    autoscaling_group_name = aws_autoscaling_group.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_autoscaling_group.test.name
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_autoscaling_start_instance_refresh.test]
+    }
+  }
+}
+`)
+}
+
+func testAccStartInstanceRefreshActionConfig_preferences(rName string) string {
+	return acctest.ConfigCompose(testAccStartInstanceRefreshActionConfig_base(rName), `
+action "aws_autoscaling_start_instance_refresh" "test" {
+  config {
+    autoscaling_group_name = aws_autoscaling_group.test.name
+
+    preferences {
+      auto_rollback          = true
+      checkpoint_delay       = 0
+      checkpoint_percentages = [50, 100]
+      instance_warmup        = 0
+      min_healthy_percentage = 0
+      skip_matching          = false
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_autoscaling_group.test.name
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_autoscaling_start_instance_refresh.test]
+    }
+  }
+}
+`)
+}
@@ -5,6 +5,7 @@ package cloudformation
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"slices"
 
@@ -17,6 +18,7 @@ import (
 	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func RegisterSweepers() {
@@ -152,27 +154,49 @@ func sweepStacks(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepabl
 		}
 
 		for _, v := range page.StackSummaries {
-			name := aws.ToString(v.StackName)
-			input := cloudformation.UpdateTerminationProtectionInput{
-				EnableTerminationProtection: aws.Bool(false),
-				StackName:                   aws.String(name),
-			}
-
-			log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", name)
-			_, err := conn.UpdateTerminationProtection(ctx, &input)
-
-			if err != nil {
-				log.Printf("[ERROR] Disabling termination protection for CloudFormation Stack (%s): %s", name, err)
-				continue
-			}
-
 			r := resourceStack()
 			d := r.Data(nil)
-			d.SetId(name)
+			d.SetId(aws.ToString(v.StackName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newStackSweeper(ctx, r, d, client))
 		}
 	}
 
 	return sweepResources, nil
 }
+
+type stackSweeper struct {
+	conn      *cloudformation.Client
+	name      string
+	sweepable interface {
+		sweep.Sweepable
+		sweep.Inspectable
+	}
+}
+
+func newStackSweeper(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, client *conns.AWSClient) sweep.Sweepable {
+	return &stackSweeper{
+		conn:      client.CloudFormationClient(ctx),
+		name:      d.Id(),
+		sweepable: sweep.NewSweepResource(resource, d, client),
+	}
+}
+
+func (s stackSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	// Termination protection is disabled here rather than when listing so that a dry run or filtered sweep leaves other stacks untouched.
+	input := cloudformation.UpdateTerminationProtectionInput{
+		EnableTerminationProtection: aws.Bool(false),
+		StackName:                   aws.String(s.name),
+	}
+
+	log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", s.name)
+	if _, err := s.conn.UpdateTerminationProtection(ctx, &input); err != nil {
+		return fmt.Errorf("disabling termination protection for CloudFormation Stack (%s): %w", s.name, err)
+	}
+
+	return s.sweepable.Delete(ctx, optFns...)
+}
+
+func (s stackSweeper) Inspect(ctx context.Context) (string, string, map[string]string) {
+	return s.sweepable.Inspect(ctx)
+}
@@ -23,7 +23,7 @@ import (
 
 func RegisterSweepers() {
 	// Keep distribution sweeper as an old-style sweeper.
-	resource.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
 		Name: "aws_cloudfront_distribution",
 		F:    sweepDistributions,
 	})
@@ -44,6 +44,7 @@ import (
 
 // @FrameworkResource("aws_dsql_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @AssumeRole
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/dsql;dsql.GetClusterOutput")
 // @Testing(importStateIdAttribute="identifier")
 // @Testing(generator=false)
@@ -367,11 +368,11 @@ func (r *clusterResource) ImportState(ctx context.Context, request resource.Impo
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrForceDestroy), types.BoolValue(false))...)
 }
 
-func findClusterByID(ctx context.Context, conn *dsql.Client, id string) (*dsql.GetClusterOutput, error) {
+func findClusterByID(ctx context.Context, conn *dsql.Client, id string, optFns ...func(*dsql.Options)) (*dsql.GetClusterOutput, error) {
 	input := dsql.GetClusterInput{
 		Identifier: aws.String(id),
 	}
-	output, err := conn.GetCluster(ctx, &input)
+	output, err := conn.GetCluster(ctx, &input, optFns...)
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return nil, &retry.NotFoundError{
@@ -545,6 +546,7 @@ func normalizeMultiRegionProperties(output *dsql.GetClusterOutput) *awstypes.Mul
 }
 
 type clusterResourceModel struct {
+	framework.WithAssumeRoleModel
 	framework.WithRegionModel
 	ARN                       types.String                                                `tfsdk:"arn"`
 	DeletionProtectionEnabled types.Bool                                                  `tfsdk:"deletion_protection_enabled"`
@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dsql/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_dsql_cluster", name="Cluster")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newClusterDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &clusterDataSource{}, nil
+}
+
+type clusterDataSource struct {
+	framework.DataSourceWithModel[clusterDataSourceModel]
+}
+
+func (d *clusterDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"deletion_protection_enabled": schema.BoolAttribute{
+				Computed: true,
+			},
+			"encryption_details": framework.DataSourceComputedListOfObjectAttribute[encryptionDetailsDataSourceModel](ctx),
+			names.AttrEndpoint: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrIdentifier: schema.StringAttribute{
+				Required: true,
+			},
+			"multi_region_properties": framework.DataSourceComputedListOfObjectAttribute[multiRegionPropertiesModel](ctx),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ClusterStatus](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			"vpc_endpoint_service_name": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *clusterDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data clusterDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().DSQLClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.Identifier)
+	output, err := findClusterByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Aurora DSQL Cluster (%s)", id), err.Error())
+
+		return
+	}
+
+	output.MultiRegionProperties = normalizeMultiRegionProperties(output)
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	vpcEndpointServiceName, err := findVPCEndpointServiceNameByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Aurora DSQL Cluster (%s) VPC endpoint service name", id), err.Error())
+
+		return
+	}
+
+	data.VPCEndpointServiceName = fwflex.StringToFramework(ctx, vpcEndpointServiceName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type clusterDataSourceModel struct {
+	framework.WithRegionModel
+	ARN                       types.String                                                      `tfsdk:"arn"`
+	CreationTime              timetypes.RFC3339                                                 `tfsdk:"creation_time"`
+	DeletionProtectionEnabled types.Bool                                                        `tfsdk:"deletion_protection_enabled"`
+	EncryptionDetails         fwtypes.ListNestedObjectValueOf[encryptionDetailsDataSourceModel] `tfsdk:"encryption_details"`
+	Endpoint                  types.String                                                      `tfsdk:"endpoint"`
+	Identifier                types.String                                                      `tfsdk:"identifier"`
+	MultiRegionProperties     fwtypes.ListNestedObjectValueOf[multiRegionPropertiesModel]       `tfsdk:"multi_region_properties"`
+	Status                    fwtypes.StringEnum[awstypes.ClusterStatus]                        `tfsdk:"status"`
+	Tags                      tftags.Map                                                        `tfsdk:"tags"`
+	VPCEndpointServiceName    types.String                                                      `tfsdk:"vpc_endpoint_service_name"`
+}
+
+type encryptionDetailsDataSourceModel struct {
+	EncryptionStatus fwtypes.StringEnum[awstypes.EncryptionStatus] `tfsdk:"encryption_status"`
+	EncryptionType   fwtypes.StringEnum[awstypes.EncryptionType]   `tfsdk:"encryption_type"`
+	KMSKeyARN        types.String                                  `tfsdk:"kms_key_arn"`
+}
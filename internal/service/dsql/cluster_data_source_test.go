@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDSQLClusterDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_dsql_cluster.test"
+	resourceName := "aws_dsql_cluster.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSQLServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterDataSourceConfig_basic(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New(names.AttrARN), resourceName, tfjsonpath.New(names.AttrARN), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New(names.AttrCreationTime), knownvalue.NotNull()),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New("deletion_protection_enabled"), resourceName, tfjsonpath.New("deletion_protection_enabled"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New(names.AttrEndpoint), knownvalue.NotNull()),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New(names.AttrIdentifier), resourceName, tfjsonpath.New(names.AttrIdentifier), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New(names.AttrStatus), knownvalue.StringExact("ACTIVE")),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+					})),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New("vpc_endpoint_service_name"), resourceName, tfjsonpath.New("vpc_endpoint_service_name"), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
+
+func testAccClusterDataSourceConfig_basic() string {
+	return `
+resource "aws_dsql_cluster" "test" {
+  deletion_protection_enabled = false
+
+  tags = {
+    key1 = "value1"
+  }
+}
+
+data "aws_dsql_cluster" "test" {
+  identifier = aws_dsql_cluster.test.identifier
+}
+`
+}
@@ -252,6 +252,68 @@ func TestAccDSQLCluster_encryption(t *testing.T) {
 	})
 }
 
+func TestAccDSQLCluster_assumeRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster dsql.GetClusterOutput
+	resourceName := "aws_dsql_cluster.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSQLServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {
+				Source:            "hashicorp/time",
+				VersionConstraint: "0.12.1",
+			},
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_assumeRole(rName, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, t, resourceName, &cluster),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("assume_role"), knownvalue.ListSizeExact(0)),
+				},
+			},
+			{
+				// The role is in the provider's account, so the cluster is updated in place.
+				Config: testAccClusterConfig_assumeRole(rName, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, t, resourceName, &cluster),
+					resource.TestCheckResourceAttrPair(resourceName, "assume_role.0.role_arn", "aws_iam_role.test1", names.AttrARN),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("assume_role"), knownvalue.ListSizeExact(1)),
+				},
+			},
+			{
+				Config: testAccClusterConfig_assumeRole(rName, "test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, t, resourceName, &cluster),
+					resource.TestCheckResourceAttrPair(resourceName, "assume_role.0.role_arn", "aws_iam_role.test2", names.AttrARN),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckClusterDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.ProviderMeta(ctx, t).DSQLClient(ctx)
@@ -320,6 +382,69 @@ resource "aws_dsql_cluster" "test" {
 `
 }
 
+func testAccClusterConfig_assumeRole(rName, roleResourceName string) string {
+	var assumeRole string
+	if roleResourceName != "" {
+		assumeRole = fmt.Sprintf(`
+  assume_role {
+    role_arn = aws_iam_role.%[1]s.arn
+  }
+`, roleResourceName)
+	}
+
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test1" {
+  name = "%[1]s-1"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test1" {
+  role       = aws_iam_role.test1.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonAuroraDSQLFullAccess"
+}
+
+resource "aws_iam_role" "test2" {
+  name = "%[1]s-2"
+
+  assume_role_policy = aws_iam_role.test1.assume_role_policy
+}
+
+resource "aws_iam_role_policy_attachment" "test2" {
+  role       = aws_iam_role.test2.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonAuroraDSQLFullAccess"
+}
+
+# Allow time for the roles to become assumable.
+resource "time_sleep" "test" {
+  create_duration = "10s"
+
+  depends_on = [
+    aws_iam_role_policy_attachment.test1,
+    aws_iam_role_policy_attachment.test2,
+  ]
+}
+
+resource "aws_dsql_cluster" "test" {
+%[2]s
+  depends_on = [time_sleep.test]
+}
+`, rName, assumeRole)
+}
+
 func testAccClusterConfig_deletionProtection(deletionProtection bool) string {
 	return fmt.Sprintf(`
 resource "aws_dsql_cluster" "test" {
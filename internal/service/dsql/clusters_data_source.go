@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dsql"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_dsql_clusters", name="Clusters")
+func newClustersDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &clustersDataSource{}, nil
+}
+
+type clustersDataSource struct {
+	framework.DataSourceWithModel[clustersDataSourceModel]
+}
+
+func (d *clustersDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARNs: schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"identifiers": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *clustersDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data clustersDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().DSQLClient(ctx)
+
+	var arns, identifiers []string
+	var input dsql.ListClustersInput
+	pages := dsql.NewListClustersPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError("listing Aurora DSQL Clusters", err.Error())
+
+			return
+		}
+
+		for _, v := range page.Clusters {
+			arns = append(arns, aws.ToString(v.Arn))
+			identifiers = append(identifiers, aws.ToString(v.Identifier))
+		}
+	}
+
+	data.ARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, arns)
+	data.Identifiers = fwflex.FlattenFrameworkStringValueListOfString(ctx, identifiers)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type clustersDataSourceModel struct {
+	framework.WithRegionModel
+	ARNs        fwtypes.ListOfString `tfsdk:"arns"`
+	Identifiers fwtypes.ListOfString `tfsdk:"identifiers"`
+}
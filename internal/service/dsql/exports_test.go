@@ -5,7 +5,8 @@ package dsql
 
 // Exports for use in tests only.
 var (
-	ResourceCluster = newClusterResource
+	ResourceCluster            = newClusterResource
+	ResourceMultiRegionCluster = newMultiRegionClusterResource
 
 	FindClusterByID = findClusterByID
 )
@@ -0,0 +1,549 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/dsql"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dsql/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_dsql_multi_region_cluster", name="Multi-Region Cluster")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/dsql;dsql.GetClusterOutput")
+// @Testing(importStateIdAttribute="identifier")
+// @Testing(generator=false)
+// @Testing(tagsTest=false)
+func newMultiRegionClusterResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &multiRegionClusterResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type multiRegionClusterResource struct {
+	framework.ResourceWithModel[multiRegionClusterResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *multiRegionClusterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"deletion_protection_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrForceDestroy: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrIdentifier: framework.IDAttribute(),
+			"peer_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"peer_identifier": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"peer_region": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSRegion(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"witness_region": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSRegion(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *multiRegionClusterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data multiRegionClusterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	peerRegion, witnessRegion := fwflex.StringValueFromFramework(ctx, data.PeerRegion), fwflex.StringValueFromFramework(ctx, data.WitnessRegion)
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	tags := getTagsIn(ctx)
+
+	// Create the cluster in the resource's Region.
+	input := dsql.CreateClusterInput{
+		ClientToken:               aws.String(sdkid.UniqueId()),
+		DeletionProtectionEnabled: fwflex.BoolFromFramework(ctx, data.DeletionProtectionEnabled),
+		MultiRegionProperties: &awstypes.MultiRegionProperties{
+			WitnessRegion: aws.String(witnessRegion),
+		},
+		Tags: tags,
+	}
+	output, err := conn.CreateCluster(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Aurora DSQL Multi-Region Cluster", err.Error())
+
+		return
+	}
+
+	id, clusterARN := aws.ToString(output.Identifier), aws.ToString(output.Arn)
+
+	// Create the peer cluster, peered with the first.
+	input = dsql.CreateClusterInput{
+		ClientToken:               aws.String(sdkid.UniqueId()),
+		DeletionProtectionEnabled: fwflex.BoolFromFramework(ctx, data.DeletionProtectionEnabled),
+		MultiRegionProperties: &awstypes.MultiRegionProperties{
+			Clusters:      []string{clusterARN},
+			WitnessRegion: aws.String(witnessRegion),
+		},
+		Tags: tags,
+	}
+	output, err = conn.CreateCluster(ctx, &input, withRegion(peerRegion))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Aurora DSQL Multi-Region Cluster (%s) peer in %s", id, peerRegion), err.Error())
+		response.Diagnostics.Append(rollbackMultiRegionCluster(ctx, conn, id, "", peerRegion, timeout)...)
+
+		return
+	}
+
+	peerID, peerARN := aws.ToString(output.Identifier), aws.ToString(output.Arn)
+
+	// Complete the peering from the first cluster's side.
+	updateInput := dsql.UpdateClusterInput{
+		ClientToken: aws.String(sdkid.UniqueId()),
+		Identifier:  aws.String(id),
+		MultiRegionProperties: &awstypes.MultiRegionProperties{
+			Clusters:      []string{peerARN},
+			WitnessRegion: aws.String(witnessRegion),
+		},
+	}
+	_, err = conn.UpdateCluster(ctx, &updateInput)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("peering Aurora DSQL Multi-Region Cluster (%s) with %s", id, peerARN), err.Error())
+		response.Diagnostics.Append(rollbackMultiRegionCluster(ctx, conn, id, peerID, peerRegion, timeout)...)
+
+		return
+	}
+
+	if err := waitMultiRegionClusterCreated(ctx, conn, id, peerID, peerRegion, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Aurora DSQL Multi-Region Cluster (%s) create", id), err.Error())
+		response.Diagnostics.Append(rollbackMultiRegionCluster(ctx, conn, id, peerID, peerRegion, timeout)...)
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringValueToFramework(ctx, clusterARN)
+	data.Identifier = fwflex.StringValueToFramework(ctx, id)
+	data.PeerARN = fwflex.StringValueToFramework(ctx, peerARN)
+	data.PeerIdentifier = fwflex.StringValueToFramework(ctx, peerID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *multiRegionClusterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data multiRegionClusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.Identifier)
+	output, err := findClusterByID(ctx, conn, id)
+
+	var properties *awstypes.MultiRegionProperties
+	if err == nil {
+		if properties = normalizeMultiRegionProperties(output); properties == nil || len(properties.Clusters) == 0 {
+			err = tfresource.NewEmptyResultError()
+		}
+	}
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Aurora DSQL Multi-Region Cluster (%s)", id), err.Error())
+
+		return
+	}
+
+	peerARN := properties.Clusters[0]
+	peerID, peerRegion, err := parseClusterARN(peerARN)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Aurora DSQL Multi-Region Cluster (%s)", id), err.Error())
+
+		return
+	}
+
+	_, err = findClusterByID(ctx, conn, peerID, withRegion(peerRegion))
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Aurora DSQL Multi-Region Cluster (%s) peer (%s)", id, peerARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.PeerARN = fwflex.StringValueToFramework(ctx, peerARN)
+	data.PeerIdentifier = fwflex.StringValueToFramework(ctx, peerID)
+	data.PeerRegion = fwflex.StringValueToFramework(ctx, peerRegion)
+	data.WitnessRegion = fwflex.StringToFramework(ctx, properties.WitnessRegion)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *multiRegionClusterResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old multiRegionClusterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	id, peerID, peerRegion := fwflex.StringValueFromFramework(ctx, new.Identifier), fwflex.StringValueFromFramework(ctx, new.PeerIdentifier), fwflex.StringValueFromFramework(ctx, new.PeerRegion)
+
+	if !new.DeletionProtectionEnabled.Equal(old.DeletionProtectionEnabled) {
+		for _, v := range multiRegionClusterMembers(id, peerID, peerRegion) {
+			input := dsql.UpdateClusterInput{
+				ClientToken:               aws.String(sdkid.UniqueId()),
+				DeletionProtectionEnabled: fwflex.BoolFromFramework(ctx, new.DeletionProtectionEnabled),
+				Identifier:                aws.String(v.id),
+			}
+			// Changing DeletionProtectionEnabled is instantaneous, no need to wait.
+			if _, err := conn.UpdateCluster(ctx, &input, v.optFns...); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating Aurora DSQL Multi-Region Cluster (%s) member (%s)", id, v.id), err.Error())
+
+				return
+			}
+		}
+	}
+
+	// Tags on the cluster in the resource's Region are handled by the transparent tagging interceptor.
+	// Mirror them on the peer.
+	if !new.TagsAll.Equal(old.TagsAll) {
+		peerARN := fwflex.StringValueFromFramework(ctx, new.PeerARN)
+		if err := updateTags(ctx, conn, peerARN, old.TagsAll, new.TagsAll, withRegion(peerRegion)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Aurora DSQL Multi-Region Cluster (%s) peer (%s) tags", id, peerARN), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *multiRegionClusterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data multiRegionClusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	id, peerID, peerRegion := fwflex.StringValueFromFramework(ctx, data.Identifier), fwflex.StringValueFromFramework(ctx, data.PeerIdentifier), fwflex.StringValueFromFramework(ctx, data.PeerRegion)
+	tflog.Debug(ctx, "deleting Aurora DSQL Multi-Region Cluster", map[string]any{
+		names.AttrIdentifier: id,
+		"peer_identifier":    peerID,
+		"peer_region":        peerRegion,
+	})
+
+	if err := deleteMultiRegionCluster(ctx, conn, id, peerID, peerRegion, data.ForceDestroy.ValueBool(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Aurora DSQL Multi-Region Cluster (%s)", id), err.Error())
+
+		return
+	}
+}
+
+func (r *multiRegionClusterResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrIdentifier), request, response)
+
+	// Set force_destroy to false on import to prevent accidental deletion
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrForceDestroy), types.BoolValue(false))...)
+}
+
+type multiRegionClusterMember struct {
+	id     string
+	optFns []func(*dsql.Options)
+}
+
+// multiRegionClusterMembers returns the clusters making up a multi-Region cluster.
+// The peer is omitted if it has not been created.
+func multiRegionClusterMembers(id, peerID, peerRegion string) []multiRegionClusterMember {
+	members := []multiRegionClusterMember{{id: id}}
+
+	if peerID != "" {
+		members = append(members, multiRegionClusterMember{id: peerID, optFns: []func(*dsql.Options){withRegion(peerRegion)}})
+	}
+
+	return members
+}
+
+func deleteMultiRegionCluster(ctx context.Context, conn *dsql.Client, id, peerID, peerRegion string, forceDestroy bool, timeout time.Duration) error {
+	members := multiRegionClusterMembers(id, peerID, peerRegion)
+
+	for _, v := range members {
+		if forceDestroy {
+			input := dsql.UpdateClusterInput{
+				ClientToken:               aws.String(sdkid.UniqueId()),
+				DeletionProtectionEnabled: aws.Bool(false),
+				Identifier:                aws.String(v.id),
+			}
+			// Changing DeletionProtectionEnabled is instantaneous, no need to wait.
+			_, err := conn.UpdateCluster(ctx, &input, v.optFns...)
+
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("disabling deletion protection for Aurora DSQL Cluster (%s): %w", v.id, err)
+			}
+		}
+	}
+
+	for _, v := range members {
+		input := dsql.DeleteClusterInput{
+			Identifier: aws.String(v.id),
+		}
+		_, err := conn.DeleteCluster(ctx, &input, v.optFns...)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting Aurora DSQL Cluster (%s): %w", v.id, err)
+		}
+	}
+
+	if err := waitMultiRegionClusterDeleted(ctx, conn, id, peerID, peerRegion, timeout); err != nil {
+		return fmt.Errorf("waiting for delete: %w", err)
+	}
+
+	return nil
+}
+
+// rollbackMultiRegionCluster deletes any clusters created by a multi-Region cluster create that subsequently failed.
+func rollbackMultiRegionCluster(ctx context.Context, conn *dsql.Client, id, peerID, peerRegion string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Clusters can't be deleted while they are still being created or peered.
+	if err := waitMultiRegionClusterStable(ctx, conn, id, peerID, peerRegion, timeout); err != nil {
+		tflog.Warn(ctx, "waiting for Aurora DSQL Multi-Region Cluster to stabilize before rollback", map[string]any{
+			names.AttrIdentifier: id,
+			"error":              err.Error(),
+		})
+	}
+
+	if err := deleteMultiRegionCluster(ctx, conn, id, peerID, peerRegion, true, timeout); err != nil {
+		diags.AddError(
+			fmt.Sprintf("rolling back Aurora DSQL Multi-Region Cluster (%s)", id),
+			fmt.Sprintf("%s\n\nThe clusters created in this and the peer Region may need to be deleted manually.", err),
+		)
+	}
+
+	return diags
+}
+
+func parseClusterARN(s string) (string, string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	id, ok := strings.CutPrefix(v.Resource, "cluster/")
+
+	if !ok || id == "" {
+		return "", "", fmt.Errorf("unexpected format for Aurora DSQL Cluster ARN (%s)", s)
+	}
+
+	return id, v.Region, nil
+}
+
+func withRegion(region string) func(*dsql.Options) {
+	return func(o *dsql.Options) {
+		o.Region = region
+	}
+}
+
+// statusMultiRegionCluster reports a single status for both clusters of a multi-Region cluster.
+// A failure on either side is reported immediately; otherwise the status of the first cluster that isn't yet active is reported.
+func statusMultiRegionCluster(conn *dsql.Client, id, peerID, peerRegion string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		var outputs []*dsql.GetClusterOutput
+
+		for _, v := range multiRegionClusterMembers(id, peerID, peerRegion) {
+			output, err := findClusterByID(ctx, conn, v.id, v.optFns...)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			if output.Status == awstypes.ClusterStatusFailed {
+				return output, string(output.Status), nil
+			}
+
+			outputs = append(outputs, output)
+		}
+
+		if len(outputs) == 0 {
+			return nil, "", nil
+		}
+
+		for _, output := range outputs {
+			if output.Status != awstypes.ClusterStatusActive {
+				return output, string(output.Status), nil
+			}
+		}
+
+		return outputs[0], string(awstypes.ClusterStatusActive), nil
+	}
+}
+
+func waitMultiRegionClusterCreated(ctx context.Context, conn *dsql.Client, id, peerID, peerRegion string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.ClusterStatusCreating, awstypes.ClusterStatusPendingSetup, awstypes.ClusterStatusUpdating),
+		Target:                    enum.Slice(awstypes.ClusterStatusActive),
+		Refresh:                   statusMultiRegionCluster(conn, id, peerID, peerRegion),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func waitMultiRegionClusterStable(ctx context.Context, conn *dsql.Client, id, peerID, peerRegion string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ClusterStatusCreating, awstypes.ClusterStatusUpdating),
+		Target:  enum.Slice(awstypes.ClusterStatusActive, awstypes.ClusterStatusPendingSetup, awstypes.ClusterStatusFailed),
+		Refresh: statusMultiRegionCluster(conn, id, peerID, peerRegion),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func waitMultiRegionClusterDeleted(ctx context.Context, conn *dsql.Client, id, peerID, peerRegion string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.ClusterStatusDeleting, awstypes.ClusterStatusPendingDelete),
+		Target:       []string{},
+		Refresh:      statusMultiRegionCluster(conn, id, peerID, peerRegion),
+		Timeout:      timeout,
+		Delay:        1 * time.Minute,
+		PollInterval: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+type multiRegionClusterResourceModel struct {
+	framework.WithRegionModel
+	ARN                       types.String   `tfsdk:"arn"`
+	DeletionProtectionEnabled types.Bool     `tfsdk:"deletion_protection_enabled"`
+	ForceDestroy              types.Bool     `tfsdk:"force_destroy"`
+	Identifier                types.String   `tfsdk:"identifier"`
+	PeerARN                   types.String   `tfsdk:"peer_arn"`
+	PeerIdentifier            types.String   `tfsdk:"peer_identifier"`
+	PeerRegion                types.String   `tfsdk:"peer_region"`
+	Tags                      tftags.Map     `tfsdk:"tags"`
+	TagsAll                   tftags.Map     `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+	WitnessRegion             types.String   `tfsdk:"witness_region"`
+}
@@ -0,0 +1,236 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/dsql"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfdsql "github.com/hashicorp/terraform-provider-aws/internal/service/dsql"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDSQLMultiRegionCluster_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var cluster, peer dsql.GetClusterOutput
+	resourceName := "aws_dsql_multi_region_cluster.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 3)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSQLServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMultiRegionClusterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionClusterConfig_basic(acctest.AlternateRegion(), acctest.ThirdRegion(), false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMultiRegionClusterExists(ctx, t, resourceName, &cluster, &peer),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "dsql", regexache.MustCompile(`cluster/.+$`)),
+					acctest.MatchResourceAttrRegionalARNRegion(ctx, resourceName, "peer_arn", "dsql", acctest.AlternateRegion(), regexache.MustCompile(`cluster/.+$`)),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("deletion_protection_enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("peer_identifier"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("peer_region"), knownvalue.StringExact(acctest.AlternateRegion())),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("witness_region"), knownvalue.StringExact(acctest.ThirdRegion())),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
+				ImportStateVerifyIgnore:              []string{names.AttrForceDestroy},
+			},
+			{
+				Config: testAccMultiRegionClusterConfig_basic(acctest.AlternateRegion(), acctest.ThirdRegion(), true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMultiRegionClusterExists(ctx, t, resourceName, &cluster, &peer),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("deletion_protection_enabled"), knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDSQLMultiRegionCluster_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var cluster, peer dsql.GetClusterOutput
+	resourceName := "aws_dsql_multi_region_cluster.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 3)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSQLServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMultiRegionClusterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionClusterConfig_tags1(acctest.AlternateRegion(), acctest.ThirdRegion(), acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMultiRegionClusterExists(ctx, t, resourceName, &cluster, &peer),
+					testAccCheckMultiRegionClusterPeerTags(&peer, map[string]string{acctest.CtKey1: acctest.CtValue1}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+					})),
+				},
+			},
+			{
+				Config: testAccMultiRegionClusterConfig_tags1(acctest.AlternateRegion(), acctest.ThirdRegion(), acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMultiRegionClusterExists(ctx, t, resourceName, &cluster, &peer),
+					testAccCheckMultiRegionClusterPeerTags(&peer, map[string]string{acctest.CtKey2: acctest.CtValue2}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey2: knownvalue.StringExact(acctest.CtValue2),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckMultiRegionClusterDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).DSQLClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dsql_multi_region_cluster" {
+				continue
+			}
+
+			for id, region := range map[string]string{
+				rs.Primary.Attributes[names.AttrIdentifier]: acctest.Region(),
+				rs.Primary.Attributes["peer_identifier"]:    rs.Primary.Attributes["peer_region"],
+			} {
+				_, err := tfdsql.FindClusterByID(ctx, conn, id, func(o *dsql.Options) {
+					o.Region = region
+				})
+
+				if retry.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("Aurora DSQL Cluster %s still exists in %s", id, region)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMultiRegionClusterExists(ctx context.Context, t *testing.T, n string, v, peer *dsql.GetClusterOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).DSQLClient(ctx)
+
+		output, err := tfdsql.FindClusterByID(ctx, conn, rs.Primary.Attributes[names.AttrIdentifier])
+
+		if err != nil {
+			return err
+		}
+
+		peerOutput, err := tfdsql.FindClusterByID(ctx, conn, rs.Primary.Attributes["peer_identifier"], func(o *dsql.Options) {
+			o.Region = rs.Primary.Attributes["peer_region"]
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+		*peer = *peerOutput
+
+		return nil
+	}
+}
+
+func testAccCheckMultiRegionClusterPeerTags(peer *dsql.GetClusterOutput, want map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for k, v := range want {
+			if got := peer.Tags[k]; got != v {
+				return fmt.Errorf("peer cluster tag %q: got %q, want %q", k, got, v)
+			}
+		}
+
+		if got, want := len(peer.Tags), len(want); got != want {
+			return fmt.Errorf("peer cluster tag count: got %d, want %d", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccMultiRegionClusterConfig_basic(peerRegion, witnessRegion string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "aws_dsql_multi_region_cluster" "test" {
+  peer_region    = %[1]q
+  witness_region = %[2]q
+
+  deletion_protection_enabled = %[3]t
+  force_destroy               = true
+}
+`, peerRegion, witnessRegion, deletionProtection)
+}
+
+func testAccMultiRegionClusterConfig_tags1(peerRegion, witnessRegion, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_dsql_multi_region_cluster" "test" {
+  peer_region    = %[1]q
+  witness_region = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, peerRegion, witnessRegion, tagKey1, tagValue1)
+}
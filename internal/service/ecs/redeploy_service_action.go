@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_ecs_redeploy_service, name="Redeploy Service")
+func newRedeployServiceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &redeployServiceAction{}, nil
+}
+
+var (
+	_ action.Action = (*redeployServiceAction)(nil)
+)
+
+type redeployServiceAction struct {
+	framework.ActionWithModel[redeployServiceActionModel]
+}
+
+type redeployServiceActionModel struct {
+	framework.WithRegionModel
+	ClusterName types.String `tfsdk:"cluster_name"`
+	ServiceName types.String `tfsdk:"service_name"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *redeployServiceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a new deployment of an ECS service and waits for the deployment to complete, reporting running and pending task counts.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrClusterName: schema.StringAttribute{
+				Description: "The name or ARN of the cluster that hosts the service",
+				Required:    true,
+			},
+			names.AttrServiceName: schema.StringAttribute{
+				Description: "The name or ARN of the service to redeploy",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the deployment to complete (default: 1200)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *redeployServiceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config redeployServiceActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := fwflex.StringValueFromFramework(ctx, config.ClusterName)
+	service := fwflex.StringValueFromFramework(ctx, config.ServiceName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 1200*time.Second)
+
+	tflog.Info(ctx, "Starting ECS redeploy service action", map[string]any{
+		names.AttrClusterName: cluster,
+		names.AttrServiceName: service,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Forcing new deployment of ECS service %s in cluster %s...", service, cluster)
+
+	// Deployments created before this time belong to earlier rollouts.
+	operationTime := time.Now().UTC()
+
+	input := ecs.UpdateServiceInput{
+		Cluster:            aws.String(cluster),
+		ForceNewDeployment: true,
+		Service:            aws.String(service),
+	}
+
+	if _, err := conn.UpdateService(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("updating ECS Service (%s)", service), err.Error())
+		return
+	}
+
+	cb(ctx, "New deployment of ECS service %s started, waiting for it to complete...", service)
+
+	// Reuse the service resource's stability check, which follows the deployment's rollout
+	// state and surfaces deployment circuit breaker failures and rollbacks as errors.
+	// No SIGINT rollback is configured, so no rollback routine is started.
+	refresh := statusServiceWaitForStable(ctx, conn, service, cluster, &rollbackState{}, operationTime)
+
+	var lastReported string
+	_, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Service], error) {
+		outputRaw, status, err := refresh()
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Service]{}, err
+		}
+
+		if outputRaw == nil {
+			return actionwait.FetchResult[*awstypes.Service]{}, fmt.Errorf("ECS Service (%s) not found", service)
+		}
+
+		output := outputRaw.(*awstypes.Service)
+
+		if progress := serviceDeploymentProgress(output); progress != lastReported {
+			lastReported = progress
+			cb(ctx, "%s", progress)
+		}
+
+		return actionwait.FetchResult[*awstypes.Service]{Status: actionwait.Status(status), Value: output}, nil
+	}, actionwait.Options[*awstypes.Service]{
+		Timeout:            timeout,
+		Interval:           actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval:   60 * time.Second,
+		SuccessStates:      []actionwait.Status{serviceStatusStable},
+		TransitionalStates: []actionwait.Status{serviceStatusPending},
+		FailureStates: []actionwait.Status{
+			serviceStatusDraining,
+			serviceStatusInactive,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "ECS service %s deployment still in progress...", service)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Service Deployment",
+				fmt.Sprintf("Deployment of ECS service %s in cluster %s did not complete within %s: %s", service, cluster, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Service Not Active",
+				fmt.Sprintf("ECS service %s in cluster %s entered status %s during deployment", service, cluster, failureErr.Status),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Service Status",
+				fmt.Sprintf("ECS service %s in cluster %s entered unexpected state: %s", service, cluster, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Service Deployment Failed",
+				fmt.Sprintf("Deployment of ECS service %s in cluster %s failed: %s", service, cluster, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Deployment of ECS service %s completed successfully", service)
+
+	tflog.Info(ctx, "ECS redeploy service action completed successfully", map[string]any{
+		names.AttrClusterName: cluster,
+		names.AttrServiceName: service,
+	})
+}
+
+// serviceDeploymentProgress returns a human-readable summary of a service's primary deployment.
+func serviceDeploymentProgress(service *awstypes.Service) string {
+	deployment := findPrimaryTaskSet(service.Deployments)
+	if deployment == nil {
+		return fmt.Sprintf("Service %s: %d running, %d pending, %d desired", aws.ToString(service.ServiceName), service.RunningCount, service.PendingCount, service.DesiredCount)
+	}
+
+	progress := fmt.Sprintf("Deployment %s: %d running, %d pending, %d desired", deployment.RolloutState, deployment.RunningCount, deployment.PendingCount, deployment.DesiredCount)
+
+	if v := aws.ToString(deployment.RolloutStateReason); v != "" {
+		progress += " (" + v + ")"
+	}
+
+	return progress
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSRedeployServiceAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service awstypes.Service
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckServiceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedeployServiceActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, t, resourceName, &service),
+					testAccCheckRedeployServiceActionSucceeded(&service),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckRedeployServiceActionSucceeded verifies that the service has a single, completed deployment.
+func testAccCheckRedeployServiceActionSucceeded(service *awstypes.Service) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		name := aws.ToString(service.ServiceName)
+
+		if got, want := len(service.Deployments), 1; got != want {
+			return fmt.Errorf("ECS Service (%s) deployment count = %d, want %d", name, got, want)
+		}
+
+		deployment := service.Deployments[0]
+		if got, want := deployment.RolloutState, awstypes.DeploymentRolloutStateCompleted; got != want {
+			return fmt.Errorf("ECS Service (%s) deployment rollout state = %s, want %s", name, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccRedeployServiceActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 0
+}
+
+action "aws_ecs_redeploy_service" "test" {
+  config {
+    cluster_name = aws_ecs_cluster.test.name
+    service_name = aws_ecs_service.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ecs_service.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_redeploy_service.test]
+    }
+  }
+}
+`, rName)
+}
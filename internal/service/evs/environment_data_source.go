@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package evs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_evs_environment", name="Environment")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newEnvironmentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &environmentDataSource{}, nil
+}
+
+type environmentDataSource struct {
+	framework.DataSourceWithModel[environmentDataSourceModel]
+}
+
+func (d *environmentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:       framework.ARNAttributeComputedOnly(),
+			"connectivity_info": framework.DataSourceComputedListOfObjectAttribute[connectivityInfoModel](ctx),
+			"credentials":       framework.DataSourceComputedListOfObjectAttribute[secretModel](ctx),
+			"environment_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"environment_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EnvironmentState](),
+				Computed:   true,
+			},
+			"environment_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CheckResult](),
+				Computed:   true,
+			},
+			"hosts": framework.DataSourceComputedListOfObjectAttribute[hostDataSourceModel](ctx),
+			names.AttrID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Computed: true,
+			},
+			"license_info": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[licenseInfoModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[licenseInfoModel](ctx),
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"service_access_security_groups": framework.DataSourceComputedListOfObjectAttribute[serviceAccessSecurityGroupsModel](ctx),
+			"service_access_subnet_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"site_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"state_details": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			"terms_accepted": schema.BoolAttribute{
+				Computed: true,
+			},
+			"vcf_hostnames": framework.DataSourceComputedListOfObjectAttribute[vcfHostnamesModel](ctx),
+			"vcf_version": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.VcfVersion](),
+				Computed:   true,
+			},
+			"vlans": framework.DataSourceComputedListOfObjectAttribute[vlanDataSourceModel](ctx),
+			names.AttrVPCID: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *environmentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data environmentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EVSClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	environment, err := findEnvironmentByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EVS Environment (%s)", id), err.Error())
+
+		return
+	}
+
+	hosts, err := findEnvironmentHosts(ctx, conn, &evs.ListEnvironmentHostsInput{
+		EnvironmentId: aws.String(id),
+	}, nil)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EVS Environment (%s) hosts", id), err.Error())
+
+		return
+	}
+
+	vlans, err := findEnvironmentVLANsByEnvironmentID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EVS Environment (%s) VLANs", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, environment, &data, fwflex.WithFieldNamePrefix("Environment"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(fwflex.Flatten(ctx, hosts, &data.Hosts)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(fwflex.Flatten(ctx, vlans, &data.VLANs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findEnvironmentVLANsByEnvironmentID(ctx context.Context, conn *evs.Client, environmentID string) ([]awstypes.Vlan, error) {
+	input := evs.ListEnvironmentVlansInput{
+		EnvironmentId: aws.String(environmentID),
+	}
+	var output []awstypes.Vlan
+
+	pages := evs.NewListEnvironmentVlansPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.EnvironmentVlans...)
+	}
+
+	return output, nil
+}
+
+type environmentDataSourceModel struct {
+	framework.WithRegionModel
+	ARN                         types.String                                                      `tfsdk:"arn"`
+	ConnectivityInfo            fwtypes.ListNestedObjectValueOf[connectivityInfoModel]            `tfsdk:"connectivity_info"`
+	Credentials                 fwtypes.ListNestedObjectValueOf[secretModel]                      `tfsdk:"credentials"`
+	EnvironmentName             types.String                                                      `tfsdk:"environment_name"`
+	EnvironmentState            fwtypes.StringEnum[awstypes.EnvironmentState]                     `tfsdk:"environment_state"`
+	EnvironmentStatus           fwtypes.StringEnum[awstypes.CheckResult]                          `tfsdk:"environment_status"`
+	Hosts                       fwtypes.ListNestedObjectValueOf[hostDataSourceModel]              `tfsdk:"hosts" autoflex:"-"`
+	ID                          types.String                                                      `tfsdk:"id"`
+	KMSKeyID                    types.String                                                      `tfsdk:"kms_key_id"`
+	LicenseInfo                 fwtypes.ListNestedObjectValueOf[licenseInfoModel]                 `tfsdk:"license_info"`
+	ServiceAccessSecurityGroups fwtypes.ListNestedObjectValueOf[serviceAccessSecurityGroupsModel] `tfsdk:"service_access_security_groups"`
+	ServiceAccessSubnetID       types.String                                                      `tfsdk:"service_access_subnet_id"`
+	SiteID                      types.String                                                      `tfsdk:"site_id"`
+	StateDetails                types.String                                                      `tfsdk:"state_details"`
+	Tags                        tftags.Map                                                        `tfsdk:"tags"`
+	TermsAccepted               types.Bool                                                        `tfsdk:"terms_accepted"`
+	VCFHostnames                fwtypes.ListNestedObjectValueOf[vcfHostnamesModel]                `tfsdk:"vcf_hostnames"`
+	VCFVersion                  fwtypes.StringEnum[awstypes.VcfVersion]                           `tfsdk:"vcf_version"`
+	VLANs                       fwtypes.ListNestedObjectValueOf[vlanDataSourceModel]              `tfsdk:"vlans" autoflex:"-"`
+	VPCID                       types.String                                                      `tfsdk:"vpc_id"`
+}
+
+type hostDataSourceModel struct {
+	DedicatedHostID  types.String                              `tfsdk:"dedicated_host_id"`
+	EC2InstanceID    types.String                              `tfsdk:"ec2_instance_id"`
+	HostName         types.String                              `tfsdk:"host_name"`
+	HostState        fwtypes.StringEnum[awstypes.HostState]    `tfsdk:"host_state"`
+	InstanceType     fwtypes.StringEnum[awstypes.InstanceType] `tfsdk:"instance_type"`
+	IPAddress        types.String                              `tfsdk:"ip_address"`
+	KeyName          types.String                              `tfsdk:"key_name"`
+	PlacementGroupID types.String                              `tfsdk:"placement_group_id"`
+}
+
+type vlanDataSourceModel struct {
+	AvailabilityZone types.String                           `tfsdk:"availability_zone"`
+	CIDR             types.String                           `tfsdk:"cidr"`
+	FunctionName     types.String                           `tfsdk:"function_name"`
+	StateDetails     types.String                           `tfsdk:"state_details"`
+	SubnetID         types.String                           `tfsdk:"subnet_id"`
+	VLANID           types.Int32                            `tfsdk:"vlan_id"`
+	VLANState        fwtypes.StringEnum[awstypes.VlanState] `tfsdk:"vlan_state"`
+}
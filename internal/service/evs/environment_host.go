@@ -0,0 +1,368 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package evs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_evs_environment_host", name="Environment Host")
+// @IdentityAttribute("environment_id")
+// @IdentityAttribute("host_name")
+// @ImportIDHandler("environmentHostImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/evs/types;awstypes;awstypes.Host")
+// @Testing(generator=false)
+// @Testing(identityTest=false)
+func newEnvironmentHostResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &environmentHostResource{}
+
+	r.SetDefaultCreateTimeout(2 * time.Hour)
+	r.SetDefaultDeleteTimeout(2 * time.Hour)
+
+	return r, nil
+}
+
+type environmentHostResource struct {
+	framework.ResourceWithModel[environmentHostResourceModel]
+	framework.WithImportByIdentity
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (r *environmentHostResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	attributes := hostInfoForCreateAttributes()
+	for k, v := range attributes {
+		v := v.(schema.StringAttribute)
+		v.PlanModifiers = []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		}
+		attributes[k] = v
+	}
+	attributes["ec2_instance_id"] = schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["environment_id"] = schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["host_state"] = schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.HostState](),
+		Computed:   true,
+	}
+	attributes[names.AttrIPAddress] = schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *environmentHostResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data environmentHostResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	environmentID, hostName := fwflex.StringValueFromFramework(ctx, data.EnvironmentID), fwflex.StringValueFromFramework(ctx, data.HostName)
+	var host awstypes.HostInfoForCreate
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &host)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input := evs.CreateEnvironmentHostInput{
+		ClientToken:   aws.String(sdkid.UniqueId()),
+		EnvironmentId: aws.String(environmentID),
+		Host:          &host,
+	}
+
+	_, err := conn.CreateEnvironmentHost(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating EVS Environment (%s) Host (%s)", environmentID, hostName), err.Error())
+
+		return
+	}
+
+	output, err := waitEnvironmentHostCreated(ctx, conn, environmentID, hostName, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EVS Environment (%s) Host (%s) create", environmentID, hostName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *environmentHostResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data environmentHostResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	environmentID, hostName := fwflex.StringValueFromFramework(ctx, data.EnvironmentID), fwflex.StringValueFromFramework(ctx, data.HostName)
+	output, err := findEnvironmentHostByTwoPartKey(ctx, conn, environmentID, hostName)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EVS Environment (%s) Host (%s)", environmentID, hostName), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *environmentHostResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data environmentHostResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	environmentID, hostName := fwflex.StringValueFromFramework(ctx, data.EnvironmentID), fwflex.StringValueFromFramework(ctx, data.HostName)
+	input := evs.DeleteEnvironmentHostInput{
+		ClientToken:   aws.String(sdkid.UniqueId()),
+		EnvironmentId: aws.String(environmentID),
+		HostName:      aws.String(hostName),
+	}
+	_, err := conn.DeleteEnvironmentHost(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EVS Environment (%s) Host (%s)", environmentID, hostName), err.Error())
+
+		return
+	}
+
+	if _, err := waitEnvironmentHostDeleted(ctx, conn, environmentID, hostName, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EVS Environment (%s) Host (%s) delete", environmentID, hostName), err.Error())
+
+		return
+	}
+}
+
+var (
+	_ inttypes.ImportIDParser = environmentHostImportID{}
+)
+
+type environmentHostImportID struct{}
+
+const (
+	environmentHostIDParts = 2
+)
+
+func (environmentHostImportID) Parse(id string) (string, map[string]any, error) {
+	parts, err := intflex.ExpandResourceId(id, environmentHostIDParts, false)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result := map[string]any{
+		"environment_id": parts[0],
+		"host_name":      parts[1],
+	}
+
+	return id, result, nil
+}
+
+func findEnvironmentHostByTwoPartKey(ctx context.Context, conn *evs.Client, environmentID, hostName string) (*awstypes.Host, error) {
+	input := evs.ListEnvironmentHostsInput{
+		EnvironmentId: aws.String(environmentID),
+	}
+	output, err := findEnvironmentHost(ctx, conn, &input, func(v *awstypes.Host) bool {
+		return aws.ToString(v.HostName) == hostName
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.HostState; state == awstypes.HostStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message: string(state),
+		}
+	}
+
+	return output, nil
+}
+
+func findEnvironmentHost(ctx context.Context, conn *evs.Client, input *evs.ListEnvironmentHostsInput, filter tfslices.Predicate[*awstypes.Host]) (*awstypes.Host, error) {
+	output, err := findEnvironmentHosts(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findEnvironmentHosts(ctx context.Context, conn *evs.Client, input *evs.ListEnvironmentHostsInput, filter tfslices.Predicate[*awstypes.Host]) ([]awstypes.Host, error) {
+	var output []awstypes.Host
+
+	pages := evs.NewListEnvironmentHostsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.EnvironmentHosts {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func statusEnvironmentHost(conn *evs.Client, environmentID, hostName string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findEnvironmentHostByTwoPartKey(ctx, conn, environmentID, hostName)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.HostState), nil
+	}
+}
+
+func waitEnvironmentHostCreated(ctx context.Context, conn *evs.Client, environmentID, hostName string, timeout time.Duration) (*awstypes.Host, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.HostStateCreating),
+		Target:       enum.Slice(awstypes.HostStateCreated),
+		Refresh:      statusEnvironmentHost(conn, environmentID, hostName),
+		Timeout:      timeout,
+		Delay:        1 * time.Minute,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Host); ok {
+		retry.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentHostDeleted(ctx context.Context, conn *evs.Client, environmentID, hostName string, timeout time.Duration) (*awstypes.Host, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.HostStateCreated, awstypes.HostStateDeleting),
+		Target:       []string{},
+		Refresh:      statusEnvironmentHost(conn, environmentID, hostName),
+		Timeout:      timeout,
+		Delay:        1 * time.Minute,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Host); ok {
+		retry.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type environmentHostResourceModel struct {
+	framework.WithRegionModel
+	DedicatedHostID  types.String                              `tfsdk:"dedicated_host_id"`
+	EC2InstanceID    types.String                              `tfsdk:"ec2_instance_id"`
+	EnvironmentID    types.String                              `tfsdk:"environment_id"`
+	HostName         types.String                              `tfsdk:"host_name"`
+	HostState        fwtypes.StringEnum[awstypes.HostState]    `tfsdk:"host_state"`
+	InstanceType     fwtypes.StringEnum[awstypes.InstanceType] `tfsdk:"instance_type"`
+	IPAddress        types.String                              `tfsdk:"ip_address"`
+	KeyName          types.String                              `tfsdk:"key_name"`
+	PlacementGroupID types.String                              `tfsdk:"placement_group_id"`
+	Timeouts         timeouts.Value                            `tfsdk:"timeouts"`
+}
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package evs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfevs "github.com/hashicorp/terraform-provider-aws/internal/service/evs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Hosts are added to an existing, fully-provisioned environment.
+const envVarEnvironmentID = "EVS_ENVIRONMENT_ID"
+
+func TestAccEVSEnvironmentHost_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.Host
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_evs_environment_host.test"
+	environmentID := acctest.SkipIfEnvVarNotSet(t, envVarEnvironmentID)
+	keyName := acctest.SkipIfEnvVarNotSet(t, envVarEnvironmentKeyName)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentHostDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentHostConfig_basic(rName, environmentID, keyName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentHostExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("ec2_instance_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("environment_id"), knownvalue.StringExact(environmentID)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("host_name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("host_state"), tfknownvalue.StringExact(awstypes.HostStateCreated)),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "environment_id", "host_name"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "host_name",
+			},
+		},
+	})
+}
+
+func TestAccEVSEnvironmentHost_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.Host
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_evs_environment_host.test"
+	environmentID := acctest.SkipIfEnvVarNotSet(t, envVarEnvironmentID)
+	keyName := acctest.SkipIfEnvVarNotSet(t, envVarEnvironmentKeyName)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentHostDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentHostConfig_basic(rName, environmentID, keyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentHostExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfevs.ResourceEnvironmentHost, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentHostDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).EVSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_evs_environment_host" {
+				continue
+			}
+
+			_, err := tfevs.FindEnvironmentHostByTwoPartKey(ctx, conn, rs.Primary.Attributes["environment_id"], rs.Primary.Attributes["host_name"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EVS Environment Host %s still exists", rs.Primary.Attributes["host_name"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentHostExists(ctx context.Context, t *testing.T, n string, v *awstypes.Host) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).EVSClient(ctx)
+
+		output, err := tfevs.FindEnvironmentHostByTwoPartKey(ctx, conn, rs.Primary.Attributes["environment_id"], rs.Primary.Attributes["host_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEnvironmentHostConfig_basic(rName, environmentID, keyName string) string {
+	return fmt.Sprintf(`
+resource "aws_evs_environment_host" "test" {
+  environment_id = %[2]q
+  host_name      = %[1]q
+  instance_type  = "i4i.metal"
+  key_name       = %[3]q
+}
+`, rName, environmentID, keyName)
+}
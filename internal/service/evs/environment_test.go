@@ -0,0 +1,277 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package evs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfevs "github.com/hashicorp/terraform-provider-aws/internal/service/evs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Environment creation requires VMware Cloud Foundation license keys and an existing,
+// pre-configured VPC (subnet and VPC Route Server peers), so these are supplied externally.
+const (
+	envVarEnvironmentKeyName             = "EVS_KEY_NAME"
+	envVarEnvironmentRouteServerPeerIDs  = "EVS_ROUTE_SERVER_PEER_IDS"
+	envVarEnvironmentServiceAccessSubnet = "EVS_SERVICE_ACCESS_SUBNET_ID"
+	envVarEnvironmentSiteID              = "EVS_SITE_ID"
+	envVarEnvironmentSolutionKey         = "EVS_VCF_SOLUTION_KEY"
+	envVarEnvironmentVPCID               = "EVS_VPC_ID"
+	envVarEnvironmentVSANKey             = "EVS_VSAN_LICENSE_KEY"
+)
+
+type environmentTestConfig struct {
+	keyName             string
+	routeServerPeerIDs  string
+	serviceAccessSubnet string
+	siteID              string
+	solutionKey         string
+	vpcID               string
+	vsanKey             string
+}
+
+func testAccEnvironmentTestConfig(t *testing.T) environmentTestConfig {
+	t.Helper()
+
+	return environmentTestConfig{
+		keyName:             acctest.SkipIfEnvVarNotSet(t, envVarEnvironmentKeyName),
+		routeServerPeerIDs:  acctest.SkipIfEnvVarNotSet(t, envVarEnvironmentRouteServerPeerIDs),
+		serviceAccessSubnet: acctest.SkipIfEnvVarNotSet(t, envVarEnvironmentServiceAccessSubnet),
+		siteID:              acctest.SkipIfEnvVarNotSet(t, envVarEnvironmentSiteID),
+		solutionKey:         acctest.SkipIfEnvVarNotSet(t, envVarEnvironmentSolutionKey),
+		vpcID:               acctest.SkipIfEnvVarNotSet(t, envVarEnvironmentVPCID),
+		vsanKey:             acctest.SkipIfEnvVarNotSet(t, envVarEnvironmentVSANKey),
+	}
+}
+
+func TestAccEVSEnvironment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.Environment
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_evs_environment.test"
+	cfg := testAccEnvironmentTestConfig(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName, cfg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("environment_name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("environment_state"), tfknownvalue.StringExact(awstypes.EnvironmentStateCreated)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("hosts"), knownvalue.ListSizeExact(4)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"hosts", "initial_vlans", "license_info", "terms_accepted"},
+			},
+		},
+	})
+}
+
+func TestAccEVSEnvironment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.Environment
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_evs_environment.test"
+	cfg := testAccEnvironmentTestConfig(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName, cfg),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfevs.ResourceEnvironment, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).EVSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_evs_environment" {
+				continue
+			}
+
+			_, err := tfevs.FindEnvironmentByID(ctx, conn, rs.Primary.Attributes[names.AttrID])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EVS Environment %s still exists", rs.Primary.Attributes[names.AttrID])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentExists(ctx context.Context, t *testing.T, n string, v *awstypes.Environment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).EVSClient(ctx)
+
+		output, err := tfevs.FindEnvironmentByID(ctx, conn, rs.Primary.Attributes[names.AttrID])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEnvironmentConfig_basic(rName string, cfg environmentTestConfig) string {
+	return fmt.Sprintf(`
+data "aws_vpc" "test" {
+  id = %[2]q
+}
+
+locals {
+  # Carve the /22 VLAN subnets out of the top of the VPC CIDR block.
+  vlan_cidrs = [for i in range(10) : cidrsubnet(data.aws_vpc.test.cidr_block, 6, 48 + i)]
+}
+
+resource "aws_evs_environment" "test" {
+  environment_name         = %[1]q
+  service_access_subnet_id = %[3]q
+  site_id                  = %[4]q
+  terms_accepted           = true
+  vcf_version              = "VCF-5.2.1"
+  vpc_id                   = data.aws_vpc.test.id
+
+  connectivity_info {
+    private_route_server_peerings = split(",", %[5]q)
+  }
+
+  dynamic "hosts" {
+    for_each = range(4)
+
+    content {
+      host_name     = "%[1]s-${hosts.value}"
+      instance_type = "i4i.metal"
+      key_name      = %[6]q
+    }
+  }
+
+  initial_vlans {
+    vmk_management {
+      cidr = local.vlan_cidrs[0]
+    }
+    vm_management {
+      cidr = local.vlan_cidrs[1]
+    }
+    vmotion {
+      cidr = local.vlan_cidrs[2]
+    }
+    vsan {
+      cidr = local.vlan_cidrs[3]
+    }
+    vtep {
+      cidr = local.vlan_cidrs[4]
+    }
+    edge_vtep {
+      cidr = local.vlan_cidrs[5]
+    }
+    nsx_uplink {
+      cidr = local.vlan_cidrs[6]
+    }
+    hcx {
+      cidr = local.vlan_cidrs[7]
+    }
+    expansion_vlan1 {
+      cidr = local.vlan_cidrs[8]
+    }
+    expansion_vlan2 {
+      cidr = local.vlan_cidrs[9]
+    }
+  }
+
+  license_info {
+    solution_key = %[7]q
+    vsan_key     = %[8]q
+  }
+
+  vcf_hostnames {
+    cloud_builder = "%[1]s-cb"
+    nsx           = "%[1]s-nsx"
+    nsx_edge1     = "%[1]s-edge1"
+    nsx_edge2     = "%[1]s-edge2"
+    nsx_manager1  = "%[1]s-nsxm1"
+    nsx_manager2  = "%[1]s-nsxm2"
+    nsx_manager3  = "%[1]s-nsxm3"
+    sddc_manager  = "%[1]s-sddc"
+    vcenter       = "%[1]s-vc"
+  }
+}
+`, rName, cfg.vpcID, cfg.serviceAccessSubnet, cfg.siteID, cfg.routeServerPeerIDs, cfg.keyName, cfg.solutionKey, cfg.vsanKey)
+}
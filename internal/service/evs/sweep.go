@@ -3,4 +3,93 @@
 
 package evs
 
-func RegisterSweepers() {}
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_evs_environment", sweepEnvironments, "aws_evs_environment_host")
+	awsv2.Register("aws_evs_environment_host", sweepEnvironmentHosts)
+}
+
+func sweepEnvironments(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EVSClient(ctx)
+	var input evs.ListEnvironmentsInput
+	var sweepResources []sweep.Sweepable
+
+	pages := evs.NewListEnvironmentsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.EnvironmentSummaries {
+			if v.EnvironmentState == awstypes.EnvironmentStateDeleted {
+				continue
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newEnvironmentResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.EnvironmentId))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepEnvironmentHosts(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EVSClient(ctx)
+	var input evs.ListEnvironmentsInput
+	var sweepResources []sweep.Sweepable
+
+	pages := evs.NewListEnvironmentsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.EnvironmentSummaries {
+			if v.EnvironmentState == awstypes.EnvironmentStateDeleted {
+				continue
+			}
+
+			environmentID := aws.ToString(v.EnvironmentId)
+			input := evs.ListEnvironmentHostsInput{
+				EnvironmentId: aws.String(environmentID),
+			}
+
+			pages := evs.NewListEnvironmentHostsPaginator(conn, &input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.EnvironmentHosts {
+					if v.HostState == awstypes.HostStateDeleted {
+						continue
+					}
+
+					sweepResources = append(sweepResources, framework.NewSweepResource(newEnvironmentHostResource, client,
+						framework.NewAttribute("environment_id", environmentID),
+						framework.NewAttribute("host_name", aws.ToString(v.HostName))))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
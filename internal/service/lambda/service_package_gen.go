@@ -30,6 +30,12 @@ func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackage
 			Name:     "Invoke",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newShiftAliasTrafficAction,
+			TypeName: "aws_lambda_shift_alias_traffic",
+			Name:     "Shift Alias Traffic",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
@@ -0,0 +1,360 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	shiftAliasTrafficStatusMonitoring actionwait.Status = "MONITORING"
+	shiftAliasTrafficStatusElapsed    actionwait.Status = "ELAPSED"
+	shiftAliasTrafficStatusAlarm      actionwait.Status = "ALARM"
+
+	shiftAliasTrafficDefaultStepPercentage = 10
+	shiftAliasTrafficDefaultInterval       = 60 * time.Second
+	shiftAliasTrafficAlarmPollInterval     = 15 * time.Second
+)
+
+// @Action(aws_lambda_shift_alias_traffic, name="Shift Alias Traffic")
+func newShiftAliasTrafficAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &shiftAliasTrafficAction{}, nil
+}
+
+var (
+	_ action.Action = (*shiftAliasTrafficAction)(nil)
+)
+
+type shiftAliasTrafficAction struct {
+	framework.ActionWithModel[shiftAliasTrafficActionModel]
+}
+
+type shiftAliasTrafficActionModel struct {
+	framework.WithRegionModel
+	FunctionName   types.String         `tfsdk:"function_name"`
+	AliasName      types.String         `tfsdk:"alias_name"`
+	TargetVersion  types.String         `tfsdk:"target_version"`
+	StepPercentage types.Int64          `tfsdk:"step_percentage"`
+	Interval       types.Int64          `tfsdk:"interval"`
+	AlarmNames     fwtypes.ListOfString `tfsdk:"alarm_names"`
+}
+
+func (a *shiftAliasTrafficAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Gradually shifts traffic on an AWS Lambda alias from its current version to a target version. Traffic is shifted in steps, CloudWatch alarms are watched between steps, and the alias is rolled back to its original version if any alarm enters the ALARM state.",
+		Attributes: map[string]schema.Attribute{
+			"function_name": schema.StringAttribute{
+				Description: "The name or ARN of the Lambda function.",
+				Required:    true,
+			},
+			"alias_name": schema.StringAttribute{
+				Description: "The name of the alias whose traffic is shifted.",
+				Required:    true,
+			},
+			"target_version": schema.StringAttribute{
+				Description: "The published function version to shift traffic to.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9]+$`), "must be a published function version"),
+				},
+			},
+			"step_percentage": schema.Int64Attribute{
+				Description: "The percentage of traffic shifted to the target version at each step. Defaults to 10.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			names.AttrInterval: schema.Int64Attribute{
+				Description: "Time in seconds to wait, watching alarms, after each step. Defaults to 60.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 3600),
+				},
+			},
+			"alarm_names": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Description: "Names of CloudWatch metric or composite alarms to watch. If any alarm enters the ALARM state, all traffic is shifted back to the original version.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(100), // DescribeAlarms limit
+					listvalidator.ValueStringsAre(
+						stringvalidator.LengthBetween(1, 255),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (a *shiftAliasTrafficAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config shiftAliasTrafficActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().LambdaClient(ctx)
+	cloudWatchConn := a.Meta().CloudWatchClient(ctx)
+
+	functionName := fwflex.StringValueFromFramework(ctx, config.FunctionName)
+	aliasName := fwflex.StringValueFromFramework(ctx, config.AliasName)
+	targetVersion := fwflex.StringValueFromFramework(ctx, config.TargetVersion)
+	alarmNames := fwflex.ExpandFrameworkStringValueList(ctx, config.AlarmNames)
+
+	stepPercentage := int64(shiftAliasTrafficDefaultStepPercentage)
+	if !config.StepPercentage.IsNull() {
+		stepPercentage = config.StepPercentage.ValueInt64()
+	}
+	interval := fwactions.TimeoutOr(config.Interval, shiftAliasTrafficDefaultInterval)
+
+	tflog.Info(ctx, "Starting Lambda shift alias traffic action", map[string]any{
+		"function_name":    functionName,
+		"alias_name":       aliasName,
+		"target_version":   targetVersion,
+		"step_percentage":  stepPercentage,
+		names.AttrInterval: interval.String(),
+		"alarm_names":      alarmNames,
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+
+	alias, err := findAliasByTwoPartKey(ctx, conn, functionName, aliasName)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("reading Lambda Alias (%s/%s)", functionName, aliasName), err.Error())
+		return
+	}
+
+	originalVersion := aws.ToString(alias.FunctionVersion)
+	if originalVersion == targetVersion {
+		if alias.RoutingConfig != nil && len(alias.RoutingConfig.AdditionalVersionWeights) > 0 {
+			if _, err := updateAliasRouting(ctx, conn, functionName, aliasName, targetVersion, nil, aws.ToString(alias.RevisionId)); err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("updating Lambda Alias (%s/%s)", functionName, aliasName), err.Error())
+				return
+			}
+		}
+		cb(ctx, "Lambda alias %s already routes all traffic to version %s", aliasName, targetVersion)
+		return
+	}
+
+	if !regexache.MustCompile(`^[0-9]+$`).MatchString(originalVersion) {
+		resp.Diagnostics.AddError(
+			"Unsupported Lambda Alias Version",
+			fmt.Sprintf("Lambda alias %s points to version %s; weighted traffic shifting requires a published version", aliasName, originalVersion),
+		)
+		return
+	}
+
+	// Don't start shifting traffic if an alarm is already firing.
+	if len(alarmNames) > 0 {
+		alarming, err := findAlarmsInAlarmState(ctx, cloudWatchConn, alarmNames)
+		if err != nil {
+			resp.Diagnostics.AddError("reading CloudWatch alarms", err.Error())
+			return
+		}
+		if len(alarming) > 0 {
+			resp.Diagnostics.AddError(
+				"CloudWatch Alarm Active",
+				fmt.Sprintf("Traffic was not shifted because CloudWatch alarms are in the ALARM state: %v", alarming),
+			)
+			return
+		}
+	}
+
+	cb(ctx, "Shifting Lambda alias %s traffic from version %s to version %s in steps of %d%%...", aliasName, originalVersion, targetVersion, stepPercentage)
+
+	revisionID := aws.ToString(alias.RevisionId)
+	for weight := stepPercentage; weight < 100; weight += stepPercentage {
+		revisionID, err = updateAliasRouting(ctx, conn, functionName, aliasName, originalVersion, map[string]float64{targetVersion: float64(weight) / 100}, revisionID)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("updating Lambda Alias (%s/%s)", functionName, aliasName), err.Error())
+			a.rollback(ctx, resp, conn, functionName, aliasName, originalVersion)
+			return
+		}
+
+		cb(ctx, "Shifted %d%% of traffic to version %s", weight, targetVersion)
+
+		if err := waitAliasTrafficStepMonitored(ctx, cloudWatchConn, alarmNames, interval, cb); err != nil {
+			var failureErr *actionwait.FailureStateError
+			if errors.As(err, &failureErr) {
+				resp.Diagnostics.AddError(
+					"CloudWatch Alarm Triggered",
+					fmt.Sprintf("A CloudWatch alarm entered the ALARM state after shifting %d%% of traffic to version %s: %s", weight, targetVersion, err),
+				)
+			} else {
+				resp.Diagnostics.AddError("Error watching CloudWatch alarms", err.Error())
+			}
+			a.rollback(ctx, resp, conn, functionName, aliasName, originalVersion)
+			return
+		}
+	}
+
+	if _, err := updateAliasRouting(ctx, conn, functionName, aliasName, targetVersion, nil, revisionID); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("updating Lambda Alias (%s/%s)", functionName, aliasName), err.Error())
+		a.rollback(ctx, resp, conn, functionName, aliasName, originalVersion)
+		return
+	}
+
+	cb(ctx, "Lambda alias %s now routes all traffic to version %s", aliasName, targetVersion)
+
+	tflog.Info(ctx, "Lambda shift alias traffic action completed successfully", map[string]any{
+		"function_name":    functionName,
+		"alias_name":       aliasName,
+		"original_version": originalVersion,
+		"target_version":   targetVersion,
+	})
+}
+
+// rollback routes all of the alias's traffic back to the original version.
+func (a *shiftAliasTrafficAction) rollback(ctx context.Context, resp *action.InvokeResponse, conn *lambda.Client, functionName, aliasName, originalVersion string) {
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Rolling back Lambda alias %s to version %s...", aliasName, originalVersion)
+
+	// Don't pass a revision ID; the rollback must win over any concurrent change.
+	if _, err := updateAliasRouting(ctx, conn, functionName, aliasName, originalVersion, nil, ""); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("rolling back Lambda Alias (%s/%s)", functionName, aliasName),
+			fmt.Sprintf("Lambda alias could not be rolled back to version %s: %s", originalVersion, err),
+		)
+		return
+	}
+
+	cb(ctx, "Lambda alias %s rolled back to version %s", aliasName, originalVersion)
+}
+
+// updateAliasRouting points the alias at the specified version with the specified additional version weights,
+// returning the alias's new revision ID.
+func updateAliasRouting(ctx context.Context, conn *lambda.Client, functionName, aliasName, version string, weights map[string]float64, revisionID string) (string, error) {
+	input := lambda.UpdateAliasInput{
+		FunctionName:    aws.String(functionName),
+		Name:            aws.String(aliasName),
+		FunctionVersion: aws.String(version),
+		RoutingConfig: &awstypes.AliasRoutingConfiguration{
+			AdditionalVersionWeights: weights,
+		},
+	}
+	if revisionID != "" {
+		input.RevisionId = aws.String(revisionID)
+	}
+
+	output, err := conn.UpdateAlias(ctx, &input)
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.RevisionId), nil
+}
+
+// waitAliasTrafficStepMonitored waits for the specified interval, failing as soon as any of the alarms is in the ALARM state.
+func waitAliasTrafficStepMonitored(ctx context.Context, conn *cloudwatch.Client, alarmNames []string, interval time.Duration, cb fwactions.SendProgressFunc) error {
+	if len(alarmNames) == 0 {
+		if interval <= 0 {
+			return nil
+		}
+		cb(ctx, "Waiting %s before the next step...", interval)
+		timer := time.NewTimer(interval)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			return nil
+		}
+	}
+
+	deadline := time.Now().Add(interval)
+	_, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[[]string], error) {
+		alarming, err := findAlarmsInAlarmState(ctx, conn, alarmNames)
+		if err != nil {
+			return actionwait.FetchResult[[]string]{}, err
+		}
+
+		switch {
+		case len(alarming) > 0:
+			return actionwait.FetchResult[[]string]{Status: shiftAliasTrafficStatusAlarm, Value: alarming}, nil
+		case !time.Now().Before(deadline):
+			return actionwait.FetchResult[[]string]{Status: shiftAliasTrafficStatusElapsed}, nil
+		default:
+			return actionwait.FetchResult[[]string]{Status: shiftAliasTrafficStatusMonitoring}, nil
+		}
+	}, actionwait.Options[[]string]{
+		Timeout:            interval + 2*shiftAliasTrafficAlarmPollInterval,
+		Interval:           actionwait.FixedInterval(min(shiftAliasTrafficAlarmPollInterval, max(interval, time.Second))),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{shiftAliasTrafficStatusElapsed},
+		TransitionalStates: []actionwait.Status{shiftAliasTrafficStatusMonitoring},
+		FailureStates:      []actionwait.Status{shiftAliasTrafficStatusAlarm},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Watching CloudWatch alarms, next step in %s", max(0, time.Until(deadline)).Truncate(time.Second))
+		},
+	})
+
+	return err
+}
+
+// findAlarmsInAlarmState returns the names of the specified alarms that are in the ALARM state.
+// An error is returned if any of the alarms does not exist.
+func findAlarmsInAlarmState(ctx context.Context, conn *cloudwatch.Client, alarmNames []string) ([]string, error) {
+	input := cloudwatch.DescribeAlarmsInput{
+		AlarmNames: alarmNames,
+		AlarmTypes: []cloudwatchtypes.AlarmType{cloudwatchtypes.AlarmTypeCompositeAlarm, cloudwatchtypes.AlarmTypeMetricAlarm},
+	}
+
+	found := make(map[string]bool, len(alarmNames))
+	var alarming []string
+	pages := cloudwatch.NewDescribeAlarmsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.MetricAlarms {
+			found[aws.ToString(v.AlarmName)] = true
+			if v.StateValue == cloudwatchtypes.StateValueAlarm {
+				alarming = append(alarming, aws.ToString(v.AlarmName))
+			}
+		}
+		for _, v := range page.CompositeAlarms {
+			found[aws.ToString(v.AlarmName)] = true
+			if v.StateValue == cloudwatchtypes.StateValueAlarm {
+				alarming = append(alarming, aws.ToString(v.AlarmName))
+			}
+		}
+	}
+
+	for _, name := range alarmNames {
+		if !found[name] {
+			return nil, fmt.Errorf("CloudWatch Alarm (%s) not found", name)
+		}
+	}
+
+	return alarming, nil
+}
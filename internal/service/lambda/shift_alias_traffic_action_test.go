@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaShiftAliasTrafficAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LambdaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShiftAliasTrafficActionConfig_basic(rName, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckShiftAliasTrafficAction(ctx, t, rName, "1"),
+				),
+			},
+			{
+				Config: testAccShiftAliasTrafficActionConfig_basic(rName, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckShiftAliasTrafficAction(ctx, t, rName, "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaShiftAliasTrafficAction_alarmNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LambdaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShiftAliasTrafficActionConfig_alarmNotFound(rName, "v1"),
+			},
+			{
+				Config:      testAccShiftAliasTrafficActionConfig_alarmNotFound(rName, "v2"),
+				ExpectError: regexache.MustCompile(`CloudWatch Alarm \(.+\) not found`),
+			},
+		},
+	})
+}
+
+// testAccCheckShiftAliasTrafficAction verifies that the alias routes all traffic to the expected version.
+func testAccCheckShiftAliasTrafficAction(ctx context.Context, t *testing.T, functionName, expectedVersion string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).LambdaClient(ctx)
+
+		input := &lambda.GetAliasInput{
+			FunctionName: aws.String(functionName),
+			Name:         aws.String("live"),
+		}
+
+		output, err := conn.GetAlias(ctx, input)
+		if err != nil {
+			return fmt.Errorf("Failed to read Lambda alias %s/live: %w", functionName, err)
+		}
+
+		if got := aws.ToString(output.FunctionVersion); got != expectedVersion {
+			return fmt.Errorf("Lambda alias %s/live version mismatch. Expected: %s, Got: %s", functionName, expectedVersion, got)
+		}
+
+		if output.RoutingConfig != nil && len(output.RoutingConfig.AdditionalVersionWeights) > 0 {
+			return fmt.Errorf("Lambda alias %s/live has additional version weights: %v", functionName, output.RoutingConfig.AdditionalVersionWeights)
+		}
+
+		return nil
+	}
+}
+
+func testAccShiftAliasTrafficActionConfig_base(rName, testData string) string {
+	return acctest.ConfigCompose(
+		testAccInvokeActionConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  depends_on = [aws_iam_role_policy_attachment.test]
+
+  filename      = "test-fixtures/lambda_invocation.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "lambda_invocation.handler"
+  runtime       = "nodejs18.x"
+  publish       = true
+
+  environment {
+    variables = {
+      TEST_DATA = %[2]q
+    }
+  }
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = "live"
+  function_name    = aws_lambda_function.test.function_name
+  function_version = "1"
+
+  lifecycle {
+    ignore_changes = [function_version, routing_config]
+  }
+}
+`, rName, testData))
+}
+
+func testAccShiftAliasTrafficActionConfig_basic(rName, testData string) string {
+	return acctest.ConfigCompose(
+		testAccShiftAliasTrafficActionConfig_base(rName, testData),
+		`
+action "aws_lambda_shift_alias_traffic" "test" {
+  config {
+    function_name   = aws_lambda_function.test.function_name
+    alias_name      = aws_lambda_alias.test.name
+    target_version  = aws_lambda_function.test.version
+    step_percentage = 50
+    interval        = 5
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_lambda_function.test.version
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.aws_lambda_shift_alias_traffic.test]
+    }
+  }
+}
+`)
+}
+
+func testAccShiftAliasTrafficActionConfig_alarmNotFound(rName, testData string) string {
+	return acctest.ConfigCompose(
+		testAccShiftAliasTrafficActionConfig_base(rName, testData),
+		fmt.Sprintf(`
+action "aws_lambda_shift_alias_traffic" "test" {
+  config {
+    function_name  = aws_lambda_function.test.function_name
+    alias_name     = aws_lambda_alias.test.name
+    target_version = aws_lambda_function.test.version
+    alarm_names    = [%[1]q]
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_lambda_function.test.version
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.aws_lambda_shift_alias_traffic.test]
+    }
+  }
+}
+`, rName))
+}
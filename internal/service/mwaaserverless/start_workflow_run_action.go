@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mwaaserverless
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mwaaserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mwaaserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_mwaaserverless_start_workflow_run, name="Start Workflow Run")
+func newStartWorkflowRunAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startWorkflowRunAction{}, nil
+}
+
+var (
+	_ action.Action = (*startWorkflowRunAction)(nil)
+)
+
+type startWorkflowRunAction struct {
+	framework.ActionWithModel[startWorkflowRunActionModel]
+}
+
+type startWorkflowRunActionModel struct {
+	framework.WithRegionModel
+	Timeout         types.Int64  `tfsdk:"timeout"`
+	WorkflowARN     fwtypes.ARN  `tfsdk:"workflow_arn"`
+	WorkflowVersion types.String `tfsdk:"workflow_version"`
+}
+
+func (a *startWorkflowRunAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a run of an MWAA Serverless workflow and waits for the run to complete.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the workflow run to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+			"workflow_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the workflow to run",
+				Required:    true,
+			},
+			"workflow_version": schema.StringAttribute{
+				Description: "The version of the workflow to run. Defaults to the latest version",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *startWorkflowRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startWorkflowRunActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().MWAAServerlessClient(ctx)
+
+	workflowARN := fwflex.StringValueFromFramework(ctx, config.WorkflowARN)
+	timeout := fwactions.TimeoutOr(config.Timeout, 3600*time.Second)
+
+	tflog.Info(ctx, "Starting MWAA Serverless start workflow run action", map[string]any{
+		"workflow_arn":    workflowARN,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting run of MWAA Serverless workflow %s...", workflowARN)
+
+	input := mwaaserverless.StartWorkflowRunInput{
+		ClientToken:     aws.String(sdkid.UniqueId()),
+		WorkflowArn:     aws.String(workflowARN),
+		WorkflowVersion: fwflex.StringFromFramework(ctx, config.WorkflowVersion),
+	}
+
+	output, err := conn.StartWorkflowRun(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("starting MWAA Serverless Workflow (%s) run", workflowARN), err.Error())
+		return
+	}
+
+	runID := aws.ToString(output.RunId)
+
+	cb(ctx, "Workflow run %s started, waiting for it to complete...", runID)
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.WorkflowRunDetail], error) {
+		output, err := findWorkflowRunByTwoPartKey(ctx, conn, workflowARN, runID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.WorkflowRunDetail]{}, err
+		}
+
+		return actionwait.FetchResult[*awstypes.WorkflowRunDetail]{Status: actionwait.Status(output.RunState), Value: output}, nil
+	}, actionwait.Options[*awstypes.WorkflowRunDetail]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: 60 * time.Second,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.WorkflowRunStatusSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.WorkflowRunStatusStarting),
+			actionwait.Status(awstypes.WorkflowRunStatusQueued),
+			actionwait.Status(awstypes.WorkflowRunStatusRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.WorkflowRunStatusFailed),
+			actionwait.Status(awstypes.WorkflowRunStatusTimeout),
+			actionwait.Status(awstypes.WorkflowRunStatusStopping),
+			actionwait.Status(awstypes.WorkflowRunStatusStopped),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Workflow run %s currently in state: %s", runID, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Workflow Run",
+				fmt.Sprintf("Run %s of MWAA Serverless workflow %s did not complete within %s: %s", runID, workflowARN, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Workflow Run Failed",
+				fmt.Sprintf("Run %s of MWAA Serverless workflow %s completed with status %s%s", runID, workflowARN, failureErr.Status, workflowRunErrorMessage(ctx, conn, workflowARN, runID)),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Workflow Run Status",
+				fmt.Sprintf("Run %s of MWAA Serverless workflow %s entered unexpected state: %s", runID, workflowARN, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Workflow Run",
+				fmt.Sprintf("Waiting for run %s of MWAA Serverless workflow %s: %s", runID, workflowARN, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Workflow run %s completed successfully", runID)
+
+	tflog.Info(ctx, "MWAA Serverless start workflow run action completed successfully", map[string]any{
+		"run_id":       runID,
+		"workflow_arn": workflowARN,
+	})
+}
+
+// workflowRunErrorMessage returns the service-reported reason for a failed workflow run, if any.
+func workflowRunErrorMessage(ctx context.Context, conn *mwaaserverless.Client, workflowARN, runID string) string {
+	output, err := findWorkflowRunByTwoPartKey(ctx, conn, workflowARN, runID)
+	if err != nil {
+		return ""
+	}
+
+	if v := aws.ToString(output.ErrorMessage); v != "" {
+		return ": " + v
+	}
+
+	return ""
+}
+
+func findWorkflowRunByTwoPartKey(ctx context.Context, conn *mwaaserverless.Client, workflowARN, runID string) (*awstypes.WorkflowRunDetail, error) {
+	input := mwaaserverless.GetWorkflowRunInput{
+		RunId:       aws.String(runID),
+		WorkflowArn: aws.String(workflowARN),
+	}
+	output, err := conn.GetWorkflowRun(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RunDetail == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.RunDetail, nil
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mwaaserverless_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/mwaaserverless"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMWAAServerlessStartWorkflowRunAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mwaaserverless.GetWorkflowOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MWAAServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckWorkflowDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				// The action fails the apply if the workflow run does not succeed.
+				Config: testAccStartWorkflowRunActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(ctx, t, "aws_mwaaserverless_workflow.test", &v),
+				),
+			},
+		},
+	})
+}
+
+func testAccStartWorkflowRunActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfig_basic(rName), `
+action "aws_mwaaserverless_start_workflow_run" "test" {
+  config {
+    workflow_arn = aws_mwaaserverless_workflow.test.arn
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_mwaaserverless_workflow.test.workflow_version
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_mwaaserverless_start_workflow_run.test]
+    }
+  }
+}
+`)
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mwaaserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mwaaserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_mwaaserverless_workflow", sweepWorkflows)
+}
+
+func sweepWorkflows(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MWAAServerlessClient(ctx)
+	var input mwaaserverless.ListWorkflowsInput
+	var sweepResources []sweep.Sweepable
+
+	pages := mwaaserverless.NewListWorkflowsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Workflows {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newWorkflowResource, client,
+				framework.NewAttribute(names.AttrARN, aws.ToString(v.WorkflowArn))))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -37,28 +38,55 @@ func sweepGraphs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepabl
 		for _, v := range page.Graphs {
 			id := aws.ToString(v.Id)
 
-			if aws.ToBool(v.DeletionProtection) {
-				input := neptunegraph.UpdateGraphInput{
-					DeletionProtection: aws.Bool(false),
-					GraphIdentifier:    aws.String(id),
-				}
-
-				if _, err := conn.UpdateGraph(ctx, &input); err != nil {
-					return nil, fmt.Errorf("updating Graph (%s) DeletionProtection: %w", id, err)
-				}
-
-				const (
-					timeout = 30 * time.Minute
-				)
-				if _, err := waitGraphUpdated(ctx, conn, id, timeout); err != nil {
-					return nil, fmt.Errorf("waiting for Graph (%s) update: %w", id, err)
-				}
-			}
-
-			sweepResources = append(sweepResources, framework.NewSweepResource(newGraphResource, client,
-				framework.NewAttribute(names.AttrID, id)))
+			sweepResources = append(sweepResources, newGraphSweeper(ctx, client, id, aws.ToBool(v.DeletionProtection)))
 		}
 	}
 
 	return sweepResources, nil
 }
+
+type graphSweeper struct {
+	conn               *neptunegraph.Client
+	id                 string
+	deletionProtection bool
+	sweepable          interface {
+		sweep.Sweepable
+		sweep.Inspectable
+	}
+}
+
+func newGraphSweeper(ctx context.Context, client *conns.AWSClient, id string, deletionProtection bool) sweep.Sweepable {
+	return &graphSweeper{
+		conn:               client.NeptuneGraphClient(ctx),
+		id:                 id,
+		deletionProtection: deletionProtection,
+		sweepable:          framework.NewSweepResource(newGraphResource, client, framework.NewAttribute(names.AttrID, id)),
+	}
+}
+
+func (s graphSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	// Deletion protection is disabled here rather than when listing so that a dry run or filtered sweep leaves other graphs untouched.
+	if s.deletionProtection {
+		input := neptunegraph.UpdateGraphInput{
+			DeletionProtection: aws.Bool(false),
+			GraphIdentifier:    aws.String(s.id),
+		}
+
+		if _, err := s.conn.UpdateGraph(ctx, &input); err != nil {
+			return fmt.Errorf("updating Graph (%s) DeletionProtection: %w", s.id, err)
+		}
+
+		const (
+			timeout = 30 * time.Minute
+		)
+		if _, err := waitGraphUpdated(ctx, s.conn, s.id, timeout); err != nil {
+			return fmt.Errorf("waiting for Graph (%s) update: %w", s.id, err)
+		}
+	}
+
+	return s.sweepable.Delete(ctx, optFns...)
+}
+
+func (s graphSweeper) Inspect(ctx context.Context) (string, string, map[string]string) {
+	return s.sweepable.Inspect(ctx)
+}
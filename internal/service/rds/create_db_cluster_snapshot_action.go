@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_create_db_cluster_snapshot, name="Create DB Cluster Snapshot")
+func newCreateDBClusterSnapshotAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createDBClusterSnapshotAction{}, nil
+}
+
+var (
+	_ action.Action = (*createDBClusterSnapshotAction)(nil)
+)
+
+type createDBClusterSnapshotAction struct {
+	framework.ActionWithModel[createDBClusterSnapshotActionModel]
+}
+
+type createDBClusterSnapshotActionModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier         types.String `tfsdk:"db_cluster_identifier"`
+	DBClusterSnapshotIdentifier types.String `tfsdk:"db_cluster_snapshot_identifier"`
+	Tags                        tftags.Map   `tfsdk:"tags"`
+	Timeout                     types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *createDBClusterSnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a manual snapshot of an RDS DB cluster and waits for it to become available, reporting progress percentage. The snapshot is not tracked in Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB cluster to snapshot",
+				Required:    true,
+			},
+			"db_cluster_snapshot_identifier": schema.StringAttribute{
+				Description: "Identifier template for the snapshot. {identifier} is replaced by the DB cluster identifier and {timestamp} by the current UTC time (default: {identifier}-{timestamp})",
+				Optional:    true,
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  tftags.MapType,
+				Description: "Tags to assign to the snapshot, merged with the provider's default tags",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the snapshot to become available (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createDBClusterSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createDBClusterSnapshotActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	clusterID := fwflex.StringValueFromFramework(ctx, config.DBClusterIdentifier)
+	timeout := fwactions.TimeoutOr(config.Timeout, 3600*time.Second)
+
+	id, err := snapshotIdentifierFromTemplate(fwflex.StringValueFromFramework(ctx, config.DBClusterSnapshotIdentifier), clusterID, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid DB Cluster Snapshot Identifier", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Starting RDS create DB cluster snapshot action", map[string]any{
+		"db_cluster_identifier":          clusterID,
+		"db_cluster_snapshot_identifier": id,
+		names.AttrTimeout:                timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Creating snapshot %s of RDS DB cluster %s...", id, clusterID)
+
+	input := rds.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         aws.String(clusterID),
+		DBClusterSnapshotIdentifier: aws.String(id),
+		Tags:                        snapshotActionTags(ctx, a.Meta().DefaultTagsConfig(ctx), config.Tags),
+	}
+
+	if _, err := conn.CreateDBClusterSnapshot(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("creating RDS DB Cluster Snapshot (%s)", id), err.Error())
+		return
+	}
+
+	cb(ctx, "Snapshot %s started, waiting for it to become available...", id)
+
+	var lastReported string
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.DBClusterSnapshot], error) {
+		outputRaw, status, err := statusDBClusterSnapshot(conn, id)(ctx)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.DBClusterSnapshot]{}, err
+		}
+
+		output, _ := outputRaw.(*awstypes.DBClusterSnapshot)
+		if output != nil {
+			if progress := fmt.Sprintf("Snapshot %s: %d%% complete", status, aws.ToInt32(output.PercentProgress)); progress != lastReported {
+				lastReported = progress
+				cb(ctx, "%s", progress)
+			}
+		}
+
+		return actionwait.FetchResult[*awstypes.DBClusterSnapshot]{Status: actionwait.Status(status), Value: output}, nil
+	}, actionwait.Options[*awstypes.DBClusterSnapshot]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: 60 * time.Second,
+		SuccessStates:    []actionwait.Status{clusterSnapshotStatusAvailable},
+		// The snapshot may not be visible immediately after creation.
+		TransitionalStates: []actionwait.Status{"", clusterSnapshotStatusCreating, clusterSnapshotStatusCopying},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Snapshot currently in state: %s", fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Cluster Snapshot",
+				fmt.Sprintf("Snapshot %s of RDS DB cluster %s did not become available within %s: %s", id, clusterID, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"DB Cluster Snapshot Failed",
+				fmt.Sprintf("Snapshot %s of RDS DB cluster %s entered unexpected state: %s", id, clusterID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Cluster Snapshot",
+				fmt.Sprintf("Error while waiting for snapshot %s of RDS DB cluster %s: %s", id, clusterID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Snapshot %s of RDS DB cluster %s is available (ARN: %s)", id, clusterID, aws.ToString(result.Value.DBClusterSnapshotArn))
+
+	tflog.Info(ctx, "RDS create DB cluster snapshot action completed successfully", map[string]any{
+		"db_cluster_identifier":   clusterID,
+		"db_cluster_snapshot_arn": aws.ToString(result.Value.DBClusterSnapshotArn),
+	})
+}
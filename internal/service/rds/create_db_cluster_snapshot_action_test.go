@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSCreateDBClusterSnapshotAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateDBClusterSnapshotActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateDBClusterSnapshotActionSucceeded(ctx, t, rName+"-manual", "Purpose", "pre-migration"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckCreateDBClusterSnapshotActionSucceeded verifies that the snapshot is available and tagged, then deletes it
+// as it is not tracked in state.
+func testAccCheckCreateDBClusterSnapshotActionSucceeded(ctx context.Context, t *testing.T, id, tagKey, tagValue string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		output, err := tfrds.FindDBClusterSnapshotByID(ctx, conn, id)
+		if err != nil {
+			return err
+		}
+
+		input := rds.DeleteDBClusterSnapshotInput{
+			DBClusterSnapshotIdentifier: aws.String(id),
+		}
+		if _, err := conn.DeleteDBClusterSnapshot(ctx, &input); err != nil {
+			return fmt.Errorf("deleting RDS DB Cluster Snapshot (%s): %w", id, err)
+		}
+
+		if got, want := aws.ToString(output.Status), "available"; got != want {
+			return fmt.Errorf("RDS DB Cluster Snapshot (%s) status = %s, want %s", id, got, want)
+		}
+
+		for _, v := range output.TagList {
+			if aws.ToString(v.Key) == tagKey && aws.ToString(v.Value) == tagValue {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("RDS DB Cluster Snapshot (%s) missing tag %s=%s", id, tagKey, tagValue)
+	}
+}
+
+func testAccCreateDBClusterSnapshotActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterSnapshotConfig_base(rName), `
+action "aws_rds_create_db_cluster_snapshot" "test" {
+  config {
+    db_cluster_identifier          = aws_rds_cluster.test.cluster_identifier
+    db_cluster_snapshot_identifier = "{identifier}-manual"
+
+    tags = {
+      Purpose = "pre-migration"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_rds_cluster.test.cluster_identifier
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_create_db_cluster_snapshot.test]
+    }
+  }
+}
+`)
+}
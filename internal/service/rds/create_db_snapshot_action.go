@@ -0,0 +1,211 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// snapshotIdentifierTemplateIdentifier is replaced by the identifier of the snapshotted DB instance or cluster.
+	snapshotIdentifierTemplateIdentifier = "{identifier}"
+	// snapshotIdentifierTemplateTimestamp is replaced by the UTC time at which the action is invoked.
+	snapshotIdentifierTemplateTimestamp = "{timestamp}"
+
+	defaultSnapshotIdentifierTemplate = snapshotIdentifierTemplateIdentifier + "-" + snapshotIdentifierTemplateTimestamp
+	snapshotIdentifierTimestampFormat = "20060102-150405"
+)
+
+// @Action(aws_rds_create_db_snapshot, name="Create DB Snapshot")
+func newCreateDBSnapshotAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createDBSnapshotAction{}, nil
+}
+
+var (
+	_ action.Action = (*createDBSnapshotAction)(nil)
+)
+
+type createDBSnapshotAction struct {
+	framework.ActionWithModel[createDBSnapshotActionModel]
+}
+
+type createDBSnapshotActionModel struct {
+	framework.WithRegionModel
+	DBInstanceIdentifier types.String `tfsdk:"db_instance_identifier"`
+	DBSnapshotIdentifier types.String `tfsdk:"db_snapshot_identifier"`
+	Tags                 tftags.Map   `tfsdk:"tags"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *createDBSnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a manual snapshot of an RDS DB instance and waits for it to become available, reporting progress percentage. The snapshot is not tracked in Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"db_instance_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB instance to snapshot",
+				Required:    true,
+			},
+			"db_snapshot_identifier": schema.StringAttribute{
+				Description: "Identifier template for the snapshot. {identifier} is replaced by the DB instance identifier and {timestamp} by the current UTC time (default: {identifier}-{timestamp})",
+				Optional:    true,
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  tftags.MapType,
+				Description: "Tags to assign to the snapshot, merged with the provider's default tags",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the snapshot to become available (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createDBSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createDBSnapshotActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	instanceID := fwflex.StringValueFromFramework(ctx, config.DBInstanceIdentifier)
+	timeout := fwactions.TimeoutOr(config.Timeout, 3600*time.Second)
+
+	id, err := snapshotIdentifierFromTemplate(fwflex.StringValueFromFramework(ctx, config.DBSnapshotIdentifier), instanceID, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid DB Snapshot Identifier", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Starting RDS create DB snapshot action", map[string]any{
+		"db_instance_identifier": instanceID,
+		"db_snapshot_identifier": id,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Creating snapshot %s of RDS DB instance %s...", id, instanceID)
+
+	input := rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(instanceID),
+		DBSnapshotIdentifier: aws.String(id),
+		Tags:                 snapshotActionTags(ctx, a.Meta().DefaultTagsConfig(ctx), config.Tags),
+	}
+
+	if _, err := conn.CreateDBSnapshot(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("creating RDS DB Snapshot (%s)", id), err.Error())
+		return
+	}
+
+	cb(ctx, "Snapshot %s started, waiting for it to become available...", id)
+
+	var lastReported string
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.DBSnapshot], error) {
+		outputRaw, status, err := statusDBSnapshot(conn, id)(ctx)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.DBSnapshot]{}, err
+		}
+
+		output, _ := outputRaw.(*awstypes.DBSnapshot)
+		if output != nil {
+			if progress := fmt.Sprintf("Snapshot %s: %d%% complete", status, aws.ToInt32(output.PercentProgress)); progress != lastReported {
+				lastReported = progress
+				cb(ctx, "%s", progress)
+			}
+		}
+
+		return actionwait.FetchResult[*awstypes.DBSnapshot]{Status: actionwait.Status(status), Value: output}, nil
+	}, actionwait.Options[*awstypes.DBSnapshot]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: 60 * time.Second,
+		SuccessStates:    []actionwait.Status{dbSnapshotAvailable},
+		// The snapshot may not be visible immediately after creation.
+		TransitionalStates: []actionwait.Status{"", dbSnapshotCreating},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Snapshot currently in state: %s", fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Snapshot",
+				fmt.Sprintf("Snapshot %s of RDS DB instance %s did not become available within %s: %s", id, instanceID, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"DB Snapshot Failed",
+				fmt.Sprintf("Snapshot %s of RDS DB instance %s entered unexpected state: %s", id, instanceID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Snapshot",
+				fmt.Sprintf("Error while waiting for snapshot %s of RDS DB instance %s: %s", id, instanceID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Snapshot %s of RDS DB instance %s is available (ARN: %s)", id, instanceID, aws.ToString(result.Value.DBSnapshotArn))
+
+	tflog.Info(ctx, "RDS create DB snapshot action completed successfully", map[string]any{
+		"db_instance_identifier": instanceID,
+		"db_snapshot_arn":        aws.ToString(result.Value.DBSnapshotArn),
+	})
+}
+
+// snapshotIdentifierFromTemplate expands a snapshot identifier template for the specified DB instance or cluster.
+func snapshotIdentifierFromTemplate(template, identifier string, now time.Time) (string, error) {
+	if template == "" {
+		template = defaultSnapshotIdentifierTemplate
+	}
+
+	id := strings.NewReplacer(
+		snapshotIdentifierTemplateIdentifier, identifier,
+		snapshotIdentifierTemplateTimestamp, now.UTC().Format(snapshotIdentifierTimestampFormat),
+	).Replace(template)
+
+	if _, errs := validIdentifier(id, "snapshot identifier"); len(errs) > 0 {
+		return "", fmt.Errorf("%q: %w", id, errors.Join(errs...))
+	}
+
+	return id, nil
+}
+
+// snapshotActionTags returns the tags to assign to a snapshot created by an action.
+func snapshotActionTags(ctx context.Context, defaultTagsConfig *tftags.DefaultConfig, tags tftags.Map) []awstypes.Tag {
+	return svcTags(defaultTagsConfig.MergeTags(tftags.New(ctx, tags)).IgnoreAWS())
+}
@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSnapshotIdentifierFromTemplate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 4, 5, 6, 7, 0, time.FixedZone("test", 3600))
+
+	testCases := map[string]struct {
+		template    string
+		identifier  string
+		expected    string
+		expectError bool
+	}{
+		"default": {
+			identifier: "my-db",
+			expected:   "my-db-20260304-040607",
+		},
+		"literal": {
+			template:   "pre-migration",
+			identifier: "my-db",
+			expected:   "pre-migration",
+		},
+		"placeholders": {
+			template:   "{identifier}-pre-migration-{timestamp}",
+			identifier: "my-db",
+			expected:   "my-db-pre-migration-20260304-040607",
+		},
+		"invalid characters": {
+			template:    "{identifier}_{timestamp}",
+			identifier:  "my-db",
+			expectError: true,
+		},
+		"trailing hyphen": {
+			template:    "{identifier}-",
+			identifier:  "my-db",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfrds.SnapshotIdentifierFromTemplate(testCase.template, testCase.identifier, now)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("SnapshotIdentifierFromTemplate(%q) error = %v, expectError %t", testCase.template, err, want)
+			}
+
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("SnapshotIdentifierFromTemplate(%q) = %q, want %q", testCase.template, got, want)
+			}
+		})
+	}
+}
+
+func TestAccRDSCreateDBSnapshotAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDBInstanceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateDBSnapshotActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateDBSnapshotActionSucceeded(ctx, t, rName+"-manual", "Purpose", "pre-migration"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckCreateDBSnapshotActionSucceeded verifies that the snapshot is available and tagged, then deletes it
+// as it is not tracked in state.
+func testAccCheckCreateDBSnapshotActionSucceeded(ctx context.Context, t *testing.T, id, tagKey, tagValue string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		output, err := tfrds.FindDBSnapshotByID(ctx, conn, id)
+		if err != nil {
+			return err
+		}
+
+		input := rds.DeleteDBSnapshotInput{
+			DBSnapshotIdentifier: aws.String(id),
+		}
+		if _, err := conn.DeleteDBSnapshot(ctx, &input); err != nil {
+			return fmt.Errorf("deleting RDS DB Snapshot (%s): %w", id, err)
+		}
+
+		if got, want := aws.ToString(output.Status), "available"; got != want {
+			return fmt.Errorf("RDS DB Snapshot (%s) status = %s, want %s", id, got, want)
+		}
+
+		for _, v := range output.TagList {
+			if aws.ToString(v.Key) == tagKey && aws.ToString(v.Value) == tagValue {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("RDS DB Snapshot (%s) missing tag %s=%s", id, tagKey, tagValue)
+	}
+}
+
+func testAccCreateDBSnapshotActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSnapshotConfig_base(rName), `
+action "aws_rds_create_db_snapshot" "test" {
+  config {
+    db_instance_identifier = aws_db_instance.test.identifier
+    db_snapshot_identifier = "{identifier}-manual"
+
+    tags = {
+      Purpose = "pre-migration"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_db_instance.test.identifier
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_create_db_snapshot.test]
+    }
+  }
+}
+`)
+}
@@ -66,6 +66,7 @@ var (
 	ParameterChunksForModify                   = parameterChunksForModify
 	ParseDBInstanceARN                         = parseDBInstanceARN
 	ProxyTargetParseResourceID                 = proxyTargetParseResourceID
+	SnapshotIdentifierFromTemplate             = snapshotIdentifierFromTemplate
 	WaitBlueGreenDeploymentDeleted             = waitBlueGreenDeploymentDeleted
 	WaitBlueGreenDeploymentAvailable           = waitBlueGreenDeploymentAvailable
 	WaitDBInstanceAvailable                    = waitDBInstanceAvailable
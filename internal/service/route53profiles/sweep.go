@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_route53profiles_profile", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_route53profiles_profile", &resource.Sweeper{
 		Name: "aws_route53profiles_profile",
 		F:    sweepProfiles,
 		Dependencies: []string{
@@ -25,7 +25,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53profiles_association", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_route53profiles_association", &resource.Sweeper{
 		Name: "aws_route53profiles_association",
 		F:    sweepProfileAssociations,
 	})
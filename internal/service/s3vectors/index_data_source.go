@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3vectors"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3vectors/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_s3vectors_index", name="Index")
+// @Tags(identifierAttribute="index_arn")
+// @Testing(tagsTest=false)
+func newIndexDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &indexDataSource{}, nil
+}
+
+type indexDataSource struct {
+	framework.DataSourceWithModel[indexDataSourceModel]
+}
+
+func (d *indexDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"data_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataType](),
+				Computed:   true,
+			},
+			"dimension": schema.Int32Attribute{
+				Computed: true,
+			},
+			"distance_metric": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DistanceMetric](),
+				Computed:   true,
+			},
+			names.AttrEncryptionConfiguration: framework.DataSourceComputedListOfObjectAttribute[indexEncryptionConfigurationModel](ctx),
+			"index_arn": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"index_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"metadata_configuration": framework.DataSourceComputedListOfObjectAttribute[indexMetadataConfigurationModel](ctx),
+			names.AttrTags:           tftags.TagsAttributeComputedOnly(),
+			"vector_bucket_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *indexDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("index_arn"),
+			path.MatchRoot("index_name"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("index_name"),
+			path.MatchRoot("vector_bucket_name"),
+		),
+	}
+}
+
+func (d *indexDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data indexDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().S3VectorsClient(ctx)
+
+	var input s3vectors.GetIndexInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id := fwflex.StringValueFromFramework(ctx, data.IndexARN)
+	if id == "" {
+		id = fwflex.StringValueFromFramework(ctx, data.IndexName)
+	}
+	output, err := findIndex(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Vectors Index (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type indexDataSourceModel struct {
+	framework.WithRegionModel
+	CreationTime            timetypes.RFC3339                                                  `tfsdk:"creation_time"`
+	DataType                fwtypes.StringEnum[awstypes.DataType]                              `tfsdk:"data_type"`
+	Dimension               types.Int32                                                        `tfsdk:"dimension"`
+	DistanceMetric          fwtypes.StringEnum[awstypes.DistanceMetric]                        `tfsdk:"distance_metric"`
+	EncryptionConfiguration fwtypes.ListNestedObjectValueOf[indexEncryptionConfigurationModel] `tfsdk:"encryption_configuration"`
+	IndexARN                types.String                                                       `tfsdk:"index_arn"`
+	IndexName               types.String                                                       `tfsdk:"index_name"`
+	MetadataConfiguration   fwtypes.ListNestedObjectValueOf[indexMetadataConfigurationModel]   `tfsdk:"metadata_configuration"`
+	Tags                    tftags.Map                                                         `tfsdk:"tags"`
+	VectorBucketName        types.String                                                       `tfsdk:"vector_bucket_name"`
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3VectorsIndexDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3vectors_index.test"
+	resourceName := "aws_s3vectors_index.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3VectorsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexDataSourceConfig_name(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New(names.AttrCreationTime), resourceName, tfjsonpath.New(names.AttrCreationTime), compare.ValuesSame()),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New("data_type"), resourceName, tfjsonpath.New("data_type"), compare.ValuesSame()),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New("dimension"), resourceName, tfjsonpath.New("dimension"), compare.ValuesSame()),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New("distance_metric"), resourceName, tfjsonpath.New("distance_metric"), compare.ValuesSame()),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New(names.AttrEncryptionConfiguration), resourceName, tfjsonpath.New(names.AttrEncryptionConfiguration), compare.ValuesSame()),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New("index_arn"), resourceName, tfjsonpath.New("index_arn"), compare.ValuesSame()),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New("index_name"), resourceName, tfjsonpath.New("index_name"), compare.ValuesSame()),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New(names.AttrTags), resourceName, tfjsonpath.New(names.AttrTags), compare.ValuesSame()),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New("vector_bucket_name"), resourceName, tfjsonpath.New("vector_bucket_name"), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3VectorsIndexDataSource_arn(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3vectors_index.test"
+	resourceName := "aws_s3vectors_index.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3VectorsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexDataSourceConfig_arn(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New("index_arn"), resourceName, tfjsonpath.New("index_arn"), compare.ValuesSame()),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New("index_name"), resourceName, tfjsonpath.New("index_name"), compare.ValuesSame()),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New("vector_bucket_name"), resourceName, tfjsonpath.New("vector_bucket_name"), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
+
+func testAccIndexDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3vectors_vector_bucket" "test" {
+  vector_bucket_name = "%[1]s-bucket"
+  force_destroy      = true
+}
+
+resource "aws_s3vectors_index" "test" {
+  index_name         = %[1]q
+  vector_bucket_name = aws_s3vectors_vector_bucket.test.vector_bucket_name
+
+  data_type       = "float32"
+  dimension       = 2
+  distance_metric = "euclidean"
+
+  tags = {
+    key1 = "value1"
+  }
+}
+`, rName)
+}
+
+func testAccIndexDataSourceConfig_name(rName string) string {
+	return acctest.ConfigCompose(testAccIndexDataSourceConfig_base(rName), `
+data "aws_s3vectors_index" "test" {
+  index_name         = aws_s3vectors_index.test.index_name
+  vector_bucket_name = aws_s3vectors_index.test.vector_bucket_name
+}
+`)
+}
+
+func testAccIndexDataSourceConfig_arn(rName string) string {
+	return acctest.ConfigCompose(testAccIndexDataSourceConfig_base(rName), `
+data "aws_s3vectors_index" "test" {
+  index_arn = aws_s3vectors_index.test.index_arn
+}
+`)
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3vectors"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3vectors/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+)
+
+// @FrameworkListResource("aws_s3vectors_index")
+func newIndexResourceAsListResource() list.ListResourceWithConfigure {
+	return &indexListResource{}
+}
+
+var _ list.ListResource = &indexListResource{}
+
+type indexListResource struct {
+	indexResource
+	framework.WithList
+}
+
+func (l *indexListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"vector_bucket_name": listschema.StringAttribute{
+				Required:    true,
+				Description: "Name of the vector bucket to list indexes from.",
+			},
+		},
+	}
+}
+
+func (l *indexListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query listIndexModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.S3VectorsClient(ctx)
+
+	vectorBucketName := fwflex.StringValueFromFramework(ctx, query.VectorBucketName)
+
+	tflog.Info(ctx, "Listing S3 Vectors Indexes", map[string]any{
+		"vector_bucket_name": vectorBucketName,
+	})
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := s3vectors.ListIndexesInput{
+			VectorBucketName: aws.String(vectorBucketName),
+		}
+		for item, err := range listIndexes(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			arn := aws.ToString(item.IndexArn)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey("index_arn"), arn)
+
+			result := request.NewListResult(ctx)
+
+			output, err := findIndexByARN(ctx, conn, arn)
+			if err != nil {
+				tflog.Error(ctx, "Reading S3 Vectors Index", map[string]any{
+					"error": err.Error(),
+				})
+				continue
+			}
+
+			var data indexResourceModel
+			l.SetResult(ctx, awsClient, request.IncludeResource, &data, &result, func() {
+				if diags := fwflex.Flatten(ctx, output, &data); diags.HasError() {
+					result.Diagnostics.Append(diags...)
+					yield(result)
+					return
+				}
+
+				result.DisplayName = aws.ToString(output.IndexName)
+			})
+
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+type listIndexModel struct {
+	framework.WithRegionModel
+	VectorBucketName types.String `tfsdk:"vector_bucket_name"`
+}
+
+func listIndexes(ctx context.Context, conn *s3vectors.Client, input *s3vectors.ListIndexesInput) iter.Seq2[awstypes.IndexSummary, error] {
+	return func(yield func(awstypes.IndexSummary, error) bool) {
+		pages := s3vectors.NewListIndexesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.IndexSummary{}, fmt.Errorf("listing S3 Vectors Index resources: %w", err))
+				return
+			}
+
+			for _, item := range page.Indexes {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,10 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_s3vectors_index" "test" {
+  provider = aws
+
+  config {
+    vector_bucket_name = aws_s3vectors_vector_bucket.test.vector_bucket_name
+  }
+}
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3VectorsVectorBucketDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3vectors_vector_bucket.test"
+	resourceName := "aws_s3vectors_vector_bucket.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3VectorsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVectorBucketDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVectorBucketDataSourceConfig_name(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New(names.AttrCreationTime), resourceName, tfjsonpath.New(names.AttrCreationTime), compare.ValuesSame()),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New(names.AttrEncryptionConfiguration), resourceName, tfjsonpath.New(names.AttrEncryptionConfiguration), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+					})),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New("vector_bucket_arn"), resourceName, tfjsonpath.New("vector_bucket_arn"), compare.ValuesSame()),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New("vector_bucket_name"), resourceName, tfjsonpath.New("vector_bucket_name"), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3VectorsVectorBucketDataSource_arn(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3vectors_vector_bucket.test"
+	resourceName := "aws_s3vectors_vector_bucket.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3VectorsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVectorBucketDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVectorBucketDataSourceConfig_arn(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New("vector_bucket_arn"), resourceName, tfjsonpath.New("vector_bucket_arn"), compare.ValuesSame()),
+					statecheck.CompareValuePairs(dataSourceName, tfjsonpath.New("vector_bucket_name"), resourceName, tfjsonpath.New("vector_bucket_name"), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
+
+func testAccVectorBucketDataSourceConfig_name(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3vectors_vector_bucket" "test" {
+  vector_bucket_name = %[1]q
+
+  tags = {
+    key1 = "value1"
+  }
+}
+
+data "aws_s3vectors_vector_bucket" "test" {
+  vector_bucket_name = aws_s3vectors_vector_bucket.test.vector_bucket_name
+}
+`, rName)
+}
+
+func testAccVectorBucketDataSourceConfig_arn(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3vectors_vector_bucket" "test" {
+  vector_bucket_name = %[1]q
+}
+
+data "aws_s3vectors_vector_bucket" "test" {
+  vector_bucket_arn = aws_s3vectors_vector_bucket.test.vector_bucket_arn
+}
+`, rName)
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3vectors"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3vectors/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+)
+
+// @FrameworkListResource("aws_s3vectors_vector_bucket")
+func newVectorBucketResourceAsListResource() list.ListResourceWithConfigure {
+	return &vectorBucketListResource{}
+}
+
+var _ list.ListResource = &vectorBucketListResource{}
+
+type vectorBucketListResource struct {
+	vectorBucketResource
+	framework.WithList
+}
+
+func (l *vectorBucketListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query listVectorBucketModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.S3VectorsClient(ctx)
+
+	tflog.Info(ctx, "Listing S3 Vectors Vector Buckets")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input s3vectors.ListVectorBucketsInput
+		for item, err := range listVectorBuckets(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			arn := aws.ToString(item.VectorBucketArn)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey("vector_bucket_arn"), arn)
+
+			result := request.NewListResult(ctx)
+
+			output, err := findVectorBucketByARN(ctx, conn, arn)
+			if err != nil {
+				tflog.Error(ctx, "Reading S3 Vectors Vector Bucket", map[string]any{
+					"error": err.Error(),
+				})
+				continue
+			}
+
+			var data vectorBucketResourceModel
+			l.SetResult(ctx, awsClient, request.IncludeResource, &data, &result, func() {
+				if diags := fwflex.Flatten(ctx, output, &data); diags.HasError() {
+					result.Diagnostics.Append(diags...)
+					yield(result)
+					return
+				}
+
+				data.ForceDestroy = types.BoolValue(false)
+				result.DisplayName = aws.ToString(output.VectorBucketName)
+			})
+
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+type listVectorBucketModel struct {
+	framework.WithRegionModel
+}
+
+func listVectorBuckets(ctx context.Context, conn *s3vectors.Client, input *s3vectors.ListVectorBucketsInput) iter.Seq2[awstypes.VectorBucketSummary, error] {
+	return func(yield func(awstypes.VectorBucketSummary, error) bool) {
+		pages := s3vectors.NewListVectorBucketsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.VectorBucketSummary{}, fmt.Errorf("listing S3 Vectors Vector Bucket resources: %w", err))
+				return
+			}
+
+			for _, item := range page.VectorBuckets {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -140,6 +140,63 @@ func TestAccSQSQueue_basic(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_assumeRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	var queueAttributes map[types.QueueAttributeName]string
+	resourceName := "aws_sqs_queue.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {
+				Source:            "hashicorp/time",
+				VersionConstraint: "0.12.1",
+			},
+		},
+		CheckDestroy: testAccCheckQueueDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_assumeRole(rName, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(ctx, t, resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "assume_role.#", "0"),
+				),
+			},
+			{
+				// The role is in the provider's account, so the queue is updated in place.
+				Config: testAccQueueConfig_assumeRole(rName, "test1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(ctx, t, resourceName, &queueAttributes),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "sqs", rName),
+					resource.TestCheckResourceAttr(resourceName, "assume_role.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "assume_role.0.role_arn", "aws_iam_role.test1", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccQueueConfig_assumeRole(rName, "test2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(ctx, t, resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "assume_role.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "assume_role.0.role_arn", "aws_iam_role.test2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var queueAttributes map[types.QueueAttributeName]string
@@ -992,6 +1049,70 @@ resource "aws_sqs_queue" "test" {
 }
 `
 
+func testAccQueueConfig_assumeRole(rName, roleResourceName string) string {
+	var assumeRole string
+	if roleResourceName != "" {
+		assumeRole = fmt.Sprintf(`
+  assume_role {
+    role_arn = aws_iam_role.%[1]s.arn
+  }
+`, roleResourceName)
+	}
+
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test1" {
+  name = "%[1]s-1"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test1" {
+  role       = aws_iam_role.test1.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonSQSFullAccess"
+}
+
+resource "aws_iam_role" "test2" {
+  name = "%[1]s-2"
+
+  assume_role_policy = aws_iam_role.test1.assume_role_policy
+}
+
+resource "aws_iam_role_policy_attachment" "test2" {
+  role       = aws_iam_role.test2.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonSQSFullAccess"
+}
+
+# Allow time for the roles to become assumable.
+resource "time_sleep" "test" {
+  create_duration = "10s"
+
+  depends_on = [
+    aws_iam_role_policy_attachment.test1,
+    aws_iam_role_policy_attachment.test2,
+  ]
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+%[2]s
+  depends_on = [time_sleep.test]
+}
+`, rName, assumeRole)
+}
+
 func testAccQueueConfig_name(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
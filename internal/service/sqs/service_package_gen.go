@@ -59,7 +59,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			}),
-			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Region:     unique.Make(inttypes.ResourceRegionDefault()),
+			AssumeRole: true,
 			Identity: inttypes.RegionalCustomInherentRegionIdentity(names.AttrURL, parseQueueURL,
 				inttypes.WithIdentityDuplicateAttrs(names.AttrID),
 				inttypes.WithVersion(1),
@@ -0,0 +1,392 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_ssm_send_command, name="Send Command")
+func newSendCommandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &sendCommandAction{}, nil
+}
+
+var (
+	_ action.Action = (*sendCommandAction)(nil)
+)
+
+type sendCommandAction struct {
+	framework.ActionWithModel[sendCommandActionModel]
+}
+
+type sendCommandActionModel struct {
+	framework.WithRegionModel
+	DocumentName    types.String                                            `tfsdk:"document_name"`
+	DocumentVersion types.String                                            `tfsdk:"document_version"`
+	InstanceIDs     fwtypes.ListOfString                                    `tfsdk:"instance_ids"`
+	Targets         fwtypes.ListNestedObjectValueOf[sendCommandTargetModel] `tfsdk:"targets"`
+	Parameters      fwtypes.MapValueOf[fwtypes.ListOfString]                `tfsdk:"parameters"`
+	Comment         types.String                                            `tfsdk:"comment"`
+	MaxConcurrency  types.String                                            `tfsdk:"max_concurrency"`
+	MaxErrors       types.String                                            `tfsdk:"max_errors"`
+	Timeout         types.Int64                                             `tfsdk:"timeout"`
+}
+
+type sendCommandTargetModel struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an AWS Systems Manager Run Command document on managed instances and waits for every invocation to complete. The action fails if any invocation does not succeed.",
+		Attributes: map[string]schema.Attribute{
+			"document_name": schema.StringAttribute{
+				Description: "The name or ARN of the SSM document to run, for example AWS-RunShellScript.",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "The SSM document version to run. Defaults to the default version of the document.",
+				Optional:    true,
+			},
+			"instance_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Description: "The IDs of the managed instances on which to run the command. Conflicts with targets.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 50), // SendCommand limit
+				},
+			},
+			names.AttrParameters: schema.MapAttribute{
+				CustomType:  fwtypes.NewMapTypeOf[fwtypes.ListOfString](ctx),
+				Description: "The parameters to pass to the SSM document, for example { commands = [\"uptime\"] }.",
+				Optional:    true,
+				ElementType: fwtypes.ListOfStringType,
+			},
+			names.AttrComment: schema.StringAttribute{
+				Description: "A comment about the command.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(100),
+				},
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "The maximum number or percentage of instances on which the command runs at the same time, for example 10 or 10%.",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "The maximum number or percentage of errors allowed before the command stops being sent to further instances, for example 1 or 10%.",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for all invocations to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(172800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": schema.ListNestedBlock{
+				Description: "Key-value pairs that select the managed instances on which to run the command, for example tag:Environment. Conflicts with instance_ids.",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[sendCommandTargetModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "The target key, for example InstanceIds, tag:<name> or tag-key.",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							Description: "The target values.",
+							Required:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sendCommandActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := fwflex.StringValueFromFramework(ctx, config.DocumentName)
+	instanceIDs := fwflex.ExpandFrameworkStringValueList(ctx, config.InstanceIDs)
+	timeout := fwactions.TimeoutOr(config.Timeout, 1800*time.Second)
+
+	targets, diags := config.Targets.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if (len(instanceIDs) == 0) == (len(targets) == 0) {
+		resp.Diagnostics.AddError(
+			"Invalid Command Targets",
+			"Exactly one of instance_ids or targets must be specified",
+		)
+		return
+	}
+
+	input := ssm.SendCommandInput{
+		Comment:         fwflex.StringFromFramework(ctx, config.Comment),
+		DocumentName:    aws.String(documentName),
+		DocumentVersion: fwflex.StringFromFramework(ctx, config.DocumentVersion),
+		InstanceIds:     instanceIDs,
+		MaxConcurrency:  fwflex.StringFromFramework(ctx, config.MaxConcurrency),
+		MaxErrors:       fwflex.StringFromFramework(ctx, config.MaxErrors),
+	}
+
+	for _, target := range targets {
+		input.Targets = append(input.Targets, awstypes.Target{
+			Key:    fwflex.StringFromFramework(ctx, target.Key),
+			Values: fwflex.ExpandFrameworkStringValueList(ctx, target.Values),
+		})
+	}
+
+	if !config.Parameters.IsNull() {
+		var parameters map[string][]string
+		resp.Diagnostics.Append(config.Parameters.ElementsAs(ctx, &parameters, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		input.Parameters = parameters
+	}
+
+	tflog.Info(ctx, "Starting SSM send command action", map[string]any{
+		"document_name":   documentName,
+		"instance_ids":    instanceIDs,
+		"target_count":    len(targets),
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Sending SSM command %s...", documentName)
+
+	output, err := conn.SendCommand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Send Command",
+			fmt.Sprintf("Could not send SSM command %s: %s", documentName, err),
+		)
+		return
+	}
+
+	commandID := aws.ToString(output.Command.CommandId)
+	cb(ctx, "SSM command %s sent, waiting for invocations to complete...", commandID)
+
+	// Report each instance's status as it changes.
+	reported := make(map[string]awstypes.CommandInvocationStatus)
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[[]awstypes.CommandInvocation], error) {
+		command, err := findCommandByID(ctx, conn, commandID)
+		if err != nil {
+			return actionwait.FetchResult[[]awstypes.CommandInvocation]{}, fmt.Errorf("reading SSM Command (%s): %w", commandID, err)
+		}
+
+		invocations, err := findCommandInvocationsByCommandID(ctx, conn, commandID)
+		if err != nil {
+			return actionwait.FetchResult[[]awstypes.CommandInvocation]{}, fmt.Errorf("listing SSM Command (%s) invocations: %w", commandID, err)
+		}
+
+		for _, v := range invocations {
+			instanceID := aws.ToString(v.InstanceId)
+			if reported[instanceID] != v.Status {
+				reported[instanceID] = v.Status
+				cb(ctx, "Instance %s: %s", instanceID, v.Status)
+			}
+		}
+
+		return actionwait.FetchResult[[]awstypes.CommandInvocation]{Status: actionwait.Status(command.Status), Value: invocations}, nil
+	}, actionwait.Options[[]awstypes.CommandInvocation]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.CommandStatusSuccess)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusPending),
+			actionwait.Status(awstypes.CommandStatusInProgress),
+			actionwait.Status(awstypes.CommandStatusCancelling),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusCancelled),
+			actionwait.Status(awstypes.CommandStatusFailed),
+			actionwait.Status(awstypes.CommandStatusTimedOut),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "SSM command %s currently in state: %s", commandID, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			// Don't leave the command running after the action has given up on it.
+			input := ssm.CancelCommandInput{
+				CommandId: aws.String(commandID),
+			}
+			if _, err := conn.CancelCommand(ctx, &input); err != nil {
+				tflog.Warn(ctx, "Failed to cancel SSM command", map[string]any{
+					"command_id": commandID,
+					"error":      err.Error(),
+				})
+			}
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Command",
+				fmt.Sprintf("SSM command %s did not complete within %s: %s", commandID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Command Failed",
+				fmt.Sprintf("SSM command %s completed with status %s\n%s", commandID, failureErr.Status, summarizeCommandInvocations(result.Value)),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Command Status",
+				fmt.Sprintf("SSM command %s entered unexpected state: %s", commandID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Command",
+				fmt.Sprintf("Error while waiting for SSM command %s: %s", commandID, err),
+			)
+		}
+		return
+	}
+
+	// A command whose targets match no managed instances succeeds without running anywhere.
+	if len(result.Value) == 0 {
+		resp.Diagnostics.AddError(
+			"Command Not Run",
+			fmt.Sprintf("SSM command %s completed without running on any instances; check that the specified instances or targets are managed instances", commandID),
+		)
+		return
+	}
+
+	// The command succeeds overall if its failures are within max_errors, but any failed invocation fails the action.
+	for _, v := range result.Value {
+		if v.Status != awstypes.CommandInvocationStatusSuccess {
+			resp.Diagnostics.AddError(
+				"Command Failed",
+				fmt.Sprintf("SSM command %s did not succeed on all instances\n%s", commandID, summarizeCommandInvocations(result.Value)),
+			)
+			return
+		}
+	}
+
+	cb(ctx, "SSM command %s completed successfully on %d instances", commandID, len(result.Value))
+
+	tflog.Info(ctx, "SSM send command action completed successfully", map[string]any{
+		"command_id":       commandID,
+		"invocation_count": len(result.Value),
+	})
+}
+
+// summarizeCommandInvocations returns one line per invocation with the instance ID and status.
+func summarizeCommandInvocations(invocations []awstypes.CommandInvocation) string {
+	lines := make([]string, 0, len(invocations))
+	for _, v := range invocations {
+		lines = append(lines, fmt.Sprintf("  %s: %s (%s)", aws.ToString(v.InstanceId), v.Status, aws.ToString(v.StatusDetails)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	return findCommand(ctx, conn, &input)
+}
+
+func findCommand(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandsInput) (*awstypes.Command, error) {
+	output, err := findCommands(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCommands(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandsInput) ([]awstypes.Command, error) {
+	var output []awstypes.Command
+
+	pages := ssm.NewListCommandsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Commands...)
+	}
+
+	return output, nil
+}
+
+func findCommandInvocationsByCommandID(ctx context.Context, conn *ssm.Client, id string) ([]awstypes.CommandInvocation, error) {
+	input := ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(id),
+	}
+
+	return findCommandInvocations(ctx, conn, &input)
+}
+
+func findCommandInvocations(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandInvocationsInput) ([]awstypes.CommandInvocation, error) {
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
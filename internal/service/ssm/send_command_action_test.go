@@ -0,0 +1,275 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMSendCommandAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandActionRegistrationSleep(),
+				),
+			},
+			{
+				Config: testAccSendCommandActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandActionSucceeded(ctx, t, "aws_instance.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_targets(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandActionRegistrationSleep(),
+				),
+			},
+			{
+				Config: testAccSendCommandActionConfig_targets(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandActionSucceeded(ctx, t, "aws_instance.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_failure(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandActionRegistrationSleep(),
+				),
+			},
+			{
+				Config:      testAccSendCommandActionConfig_failure(rName),
+				ExpectError: regexache.MustCompile(`Command Failed`),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_noInstances(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSendCommandActionConfig_noInstances(rName),
+				ExpectError: regexache.MustCompile(`Command Not Run`),
+			},
+		},
+	})
+}
+
+func testAccCheckSendCommandActionRegistrationSleep() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		log.Print("[DEBUG] Test: Sleep to allow SSM Agent to register EC2 instance as a managed node.")
+		time.Sleep(1 * time.Minute)
+		return nil
+	}
+}
+
+// testAccCheckSendCommandActionSucceeded verifies that a command ran successfully on the instance.
+func testAccCheckSendCommandActionSucceeded(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).SSMClient(ctx)
+
+		input := &ssm.ListCommandInvocationsInput{
+			InstanceId: &rs.Primary.ID,
+		}
+
+		output, err := conn.ListCommandInvocations(ctx, input)
+		if err != nil {
+			return fmt.Errorf("Failed to list SSM command invocations for instance %s: %w", rs.Primary.ID, err)
+		}
+
+		for _, v := range output.CommandInvocations {
+			if v.Status == awstypes.CommandInvocationStatusSuccess {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("No successful SSM command invocations found for instance %s", rs.Primary.ID)
+	}
+}
+
+func testAccSendCommandActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    instance_ids  = [aws_instance.test.id]
+
+    parameters = {
+      commands = ["uptime"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`)
+}
+
+func testAccSendCommandActionConfig_targets(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    comment       = "terraform acceptance test"
+
+    targets {
+      key    = "tag:Name"
+      values = [%[1]q]
+    }
+
+    parameters = {
+      commands = ["uptime"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`, rName))
+}
+
+func testAccSendCommandActionConfig_failure(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    instance_ids  = [aws_instance.test.id]
+
+    parameters = {
+      commands = ["exit 1"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`)
+}
+
+func testAccSendCommandActionConfig_noInstances(rName string) string {
+	return fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+
+    targets {
+      key    = "tag:Name"
+      values = [%[1]q]
+    }
+
+    parameters = {
+      commands = ["uptime"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// Inspectable is implemented by Sweepables that can report the ID, name and tags of the resource to be deleted.
+// Only Inspectable resources are swept when a name prefix or tag filter is configured.
+type Inspectable interface {
+	Inspect(ctx context.Context) (id, name string, tags map[string]string)
+}
+
+// filter limits the resources deleted by SweepOrchestrator.
+type filter struct {
+	dryRun       bool
+	namePrefixes []string
+	tags         map[string]string
+}
+
+// filterFromEnv returns the filter configured by environment variables.
+func filterFromEnv() (*filter, error) {
+	f := &filter{
+		dryRun: os.Getenv(envvar.SweepDryRun) != "",
+	}
+
+	if v := os.Getenv(envvar.SweepNamePrefixes); v != "" {
+		for prefix := range strings.SplitSeq(v, ",") {
+			if prefix = strings.TrimSpace(prefix); prefix != "" {
+				f.namePrefixes = append(f.namePrefixes, prefix)
+			}
+		}
+	}
+
+	if v := os.Getenv(envvar.SweepTags); v != "" {
+		f.tags = make(map[string]string)
+		for selector := range strings.SplitSeq(v, ",") {
+			if selector = strings.TrimSpace(selector); selector == "" {
+				continue
+			}
+
+			key, value, ok := strings.Cut(selector, "=")
+			if !ok || key == "" {
+				return nil, fmt.Errorf("environment variable %s: invalid tag selector %q, expected key=value", envvar.SweepTags, selector)
+			}
+
+			f.tags[key] = value
+		}
+	}
+
+	return f, nil
+}
+
+// enabled returns whether the filter limits the resources to be deleted.
+func (f *filter) enabled() bool {
+	return len(f.namePrefixes) > 0 || len(f.tags) > 0
+}
+
+// skips returns whether the named sweeper must be skipped because it cannot honor the filter.
+func (f *filter) skips(name string) bool {
+	return (f.dryRun || f.enabled()) && !orchestrated[name]
+}
+
+// apply returns the Sweepables matching the filter.
+func (f *filter) apply(ctx context.Context, sweepables []Sweepable) []Sweepable {
+	if !f.enabled() {
+		return sweepables
+	}
+
+	var result []Sweepable
+
+	for _, sweepable := range sweepables {
+		v, ok := sweepable.(Inspectable)
+		if !ok {
+			tflog.Warn(ctx, "Skipping resource that cannot be inspected for sweeper filters")
+			continue
+		}
+
+		id, name, tags := v.Inspect(ctx)
+		ctx := tflog.SetField(ctx, "id", id)
+
+		if !f.matchesName(id, name) {
+			tflog.Debug(ctx, "Skipping resource not matching name prefixes")
+			continue
+		}
+
+		if !f.matchesTags(tags) {
+			tflog.Debug(ctx, "Skipping resource not matching tags")
+			continue
+		}
+
+		result = append(result, sweepable)
+	}
+
+	return result
+}
+
+func (f *filter) matchesName(id, name string) bool {
+	if len(f.namePrefixes) == 0 {
+		return true
+	}
+
+	if name == "" {
+		name = id
+	}
+
+	for _, prefix := range f.namePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *filter) matchesTags(tags map[string]string) bool {
+	for k, v := range f.tags {
+		if value, ok := tags[k]; !ok || value != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// inspectContext returns a Context with logging fields describing the Sweepable, if known.
+func inspectContext(ctx context.Context, sweepable Sweepable) context.Context {
+	if v, ok := sweepable.(Inspectable); ok {
+		id, name, _ := v.Inspect(ctx)
+		ctx = tflog.SetField(ctx, "id", id)
+		if name != "" {
+			ctx = tflog.SetField(ctx, "name", name)
+		}
+	}
+
+	return ctx
+}
@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	id string
+}
+
+func (testSweepable) Delete(context.Context, ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+type testInspectable struct {
+	testSweepable
+	name string
+	tags map[string]string
+}
+
+func (v testInspectable) Inspect(context.Context) (string, string, map[string]string) {
+	return v.id, v.name, v.tags
+}
+
+func TestFilterFromEnv(t *testing.T) {
+	testCases := map[string]struct {
+		dryRun        string
+		namePrefixes  string
+		tags          string
+		expected      *filter
+		expectEnabled bool
+		expectError   bool
+	}{
+		"empty": {
+			expected: &filter{},
+		},
+		"dry run": {
+			dryRun:   "1",
+			expected: &filter{dryRun: true},
+		},
+		"name prefixes": {
+			namePrefixes:  " tf-acc-test , ,terraform-",
+			expected:      &filter{namePrefixes: []string{"tf-acc-test", "terraform-"}},
+			expectEnabled: true,
+		},
+		"tags": {
+			tags:          "Owner=tf-acc-test, Empty=,,Env=a=b",
+			expected:      &filter{tags: map[string]string{"Owner": "tf-acc-test", "Empty": "", "Env": "a=b"}},
+			expectEnabled: true,
+		},
+		"tag without value": {
+			tags:        "Owner",
+			expectError: true,
+		},
+		"tag without key": {
+			tags:        "=tf-acc-test",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(envvar.SweepDryRun, testCase.dryRun)
+			t.Setenv(envvar.SweepNamePrefixes, testCase.namePrefixes)
+			t.Setenv(envvar.SweepTags, testCase.tags)
+
+			got, err := filterFromEnv()
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("filterFromEnv() err %t, want %t: %v", got, want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(filter{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, want := got.enabled(), testCase.expectEnabled; got != want {
+				t.Errorf("enabled() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestFilterMatchesName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		namePrefixes []string
+		id           string
+		name         string
+		expected     bool
+	}{
+		"no prefixes": {
+			id:       "i-123",
+			expected: true,
+		},
+		"name matches": {
+			namePrefixes: []string{"other-", "tf-acc-test"},
+			id:           "i-123",
+			name:         "tf-acc-test-123",
+			expected:     true,
+		},
+		"name does not match": {
+			namePrefixes: []string{"tf-acc-test"},
+			id:           "tf-acc-test-123",
+			name:         "production",
+		},
+		"ID matches without name": {
+			namePrefixes: []string{"tf-acc-test"},
+			id:           "tf-acc-test-123",
+			expected:     true,
+		},
+		"ID does not match without name": {
+			namePrefixes: []string{"tf-acc-test"},
+			id:           "i-123",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			f := &filter{namePrefixes: testCase.namePrefixes}
+
+			if got, want := f.matchesName(testCase.id, testCase.name), testCase.expected; got != want {
+				t.Errorf("matchesName(%q, %q) = %t, want %t", testCase.id, testCase.name, got, want)
+			}
+		})
+	}
+}
+
+func TestFilterMatchesTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		selectors map[string]string
+		tags      map[string]string
+		expected  bool
+	}{
+		"no selectors": {
+			expected: true,
+		},
+		"no tags": {
+			selectors: map[string]string{"Owner": "tf-acc-test"},
+		},
+		"all match": {
+			selectors: map[string]string{"Owner": "tf-acc-test", "Env": "test"},
+			tags:      map[string]string{"Owner": "tf-acc-test", "Env": "test", "Name": "example"},
+			expected:  true,
+		},
+		"some match": {
+			selectors: map[string]string{"Owner": "tf-acc-test", "Env": "test"},
+			tags:      map[string]string{"Owner": "tf-acc-test"},
+		},
+		"value differs": {
+			selectors: map[string]string{"Owner": "tf-acc-test"},
+			tags:      map[string]string{"Owner": "someone-else"},
+		},
+		"empty value": {
+			selectors: map[string]string{"Owner": ""},
+			tags:      map[string]string{"Owner": ""},
+			expected:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			f := &filter{tags: testCase.selectors}
+
+			if got, want := f.matchesTags(testCase.tags), testCase.expected; got != want {
+				t.Errorf("matchesTags(%v) = %t, want %t", testCase.tags, got, want)
+			}
+		})
+	}
+}
+
+func TestFilterApply(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	notInspectable := testSweepable{id: "tf-acc-test-1"}
+	namedTagged := testInspectable{testSweepable: testSweepable{id: "i-1"}, name: "tf-acc-test-1", tags: map[string]string{"Owner": "tf-acc-test"}}
+	named := testInspectable{testSweepable: testSweepable{id: "i-2"}, name: "tf-acc-test-2"}
+	idOnly := testInspectable{testSweepable: testSweepable{id: "tf-acc-test-3"}}
+	other := testInspectable{testSweepable: testSweepable{id: "i-4"}, name: "production", tags: map[string]string{"Owner": "tf-acc-test"}}
+	sweepables := []Sweepable{notInspectable, namedTagged, named, idOnly, other}
+
+	testCases := map[string]struct {
+		filter   *filter
+		expected []Sweepable
+	}{
+		"not enabled": {
+			filter:   &filter{},
+			expected: sweepables,
+		},
+		"dry run": {
+			filter:   &filter{dryRun: true},
+			expected: sweepables,
+		},
+		"name prefixes": {
+			filter:   &filter{namePrefixes: []string{"tf-acc-test"}},
+			expected: []Sweepable{namedTagged, named, idOnly},
+		},
+		"tags": {
+			filter:   &filter{tags: map[string]string{"Owner": "tf-acc-test"}},
+			expected: []Sweepable{namedTagged, other},
+		},
+		"name prefixes and tags": {
+			filter:   &filter{namePrefixes: []string{"tf-acc-test"}, tags: map[string]string{"Owner": "tf-acc-test"}},
+			expected: []Sweepable{namedTagged},
+		},
+		"no matches": {
+			filter: &filter{namePrefixes: []string{"none"}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.filter.apply(ctx, sweepables)
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(testSweepable{}, testInspectable{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -111,6 +111,36 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return err
 }
 
+// Inspect returns the ID, name and tags of the resource to be deleted, as known to the sweeper.
+func (sr *sweepResource) Inspect(context.Context) (string, string, map[string]string) {
+	var id, name string
+	var tags map[string]string
+
+	for _, attr := range sr.attributes {
+		switch attr.path {
+		case names.AttrID:
+			id = attributeStringValue(attr.value)
+		case names.AttrName:
+			name = attributeStringValue(attr.value)
+		case names.AttrTags:
+			tags, _ = attr.value.(map[string]string)
+		}
+	}
+
+	return id, name, tags
+}
+
+func attributeStringValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case *string:
+		return aws.ToString(v)
+	default:
+		return ""
+	}
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -123,6 +123,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaaserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptunegraph"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
@@ -310,6 +311,7 @@ func registerSweepers() {
 	memorydb.RegisterSweepers()
 	mq.RegisterSweepers()
 	mwaa.RegisterSweepers()
+	mwaaserverless.RegisterSweepers()
 	neptune.RegisterSweepers()
 	neptunegraph.RegisterSweepers()
 	networkfirewall.RegisterSweepers()
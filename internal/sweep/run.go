@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+)
+
+const defaultSweeperParallelism = 8
+
+var (
+	// sweepers contains all registered sweepers, keyed by name.
+	sweepers = make(map[string]*resource.Sweeper)
+	// orchestrated contains the names of registered sweepers that only delete resources via SweepOrchestrator.
+	orchestrated = make(map[string]bool)
+)
+
+// AddTestSweepers registers a sweeper.
+// Sweepers registered here are run by RunSweepers in dependency order.
+// They are skipped when a dry run or a name prefix or tag filter is configured.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	sweepers[name] = s
+
+	resource.AddTestSweepers(name, s)
+}
+
+// AddOrchestratedTestSweepers registers a sweeper that only deletes resources via SweepOrchestrator.
+// Unlike sweepers registered with AddTestSweepers, such sweepers are run when a dry run or a name prefix or tag filter is configured.
+func AddOrchestratedTestSweepers(name string, s *resource.Sweeper) {
+	AddTestSweepers(name, s)
+
+	orchestrated[name] = true
+}
+
+// RunSweepers runs the registered sweepers whose names contain any of the comma-separated values in filter,
+// together with their dependencies, in each of the specified Regions.
+// Sweepers are scheduled from a dependency graph, with independent sweepers run concurrently.
+// A sweeper is only run once all of its dependencies have run successfully, unless allowFailures is set.
+// When a dry run or a name prefix or tag filter is configured, sweepers that delete resources other than via SweepOrchestrator are skipped.
+func RunSweepers(ctx context.Context, regions []string, filter string, allowFailures bool) error {
+	g, err := sweeperGraph(filter)
+	if err != nil {
+		return err
+	}
+
+	f, err := filterFromEnv()
+	if err != nil {
+		return err
+	}
+
+	parallelism := defaultSweeperParallelism
+	if v := os.Getenv(envvar.SweepParallelism); v != "" {
+		parallelism, err = strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("environment variable %s: %w", envvar.SweepParallelism, err)
+		}
+	}
+
+	var errs []error
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+		ctx := regionalContext(ctx, region)
+
+		var (
+			failures []error
+			mutex    sync.Mutex
+		)
+
+		tflog.Info(ctx, "Running sweepers", map[string]any{
+			"sweepers":    g.Len(),
+			"parallelism": parallelism,
+		})
+		start := time.Now()
+
+		err := g.Walk(ctx, parallelism, func(ctx context.Context, name string) error {
+			ctx = log.WithResourceType(ctx, name)
+
+			var err error
+			if s, ok := sweepers[name]; ok {
+				if f.skips(name) {
+					tflog.Warn(ctx, "Skipping sweeper that does not support dry run or filters")
+					return nil
+				}
+
+				start := time.Now()
+				err = s.F(region)
+				ctx = tflog.SetField(ctx, "duration", time.Since(start).String())
+			} else {
+				err = errors.New("sweeper not found")
+			}
+
+			if err != nil {
+				err = fmt.Errorf("sweeper (%s) for region (%s) failed: %w", name, region, err)
+				tflog.Error(ctx, "Sweeper failed", map[string]any{
+					"error": err.Error(),
+				})
+
+				if allowFailures {
+					mutex.Lock()
+					failures = append(failures, err)
+					mutex.Unlock()
+
+					return nil
+				}
+
+				return err
+			}
+
+			tflog.Info(ctx, "Sweeper completed")
+
+			return nil
+		})
+
+		tflog.Info(ctx, "Completed sweepers", map[string]any{
+			"duration": time.Since(start).String(),
+		})
+
+		if err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+
+		errs = append(errs, failures...)
+	}
+
+	return errors.Join(errs...)
+}
+
+// sweeperGraph returns the dependency graph of the registered sweepers matching filter.
+func sweeperGraph(filter string) (*depgraph.Graph, error) {
+	all := depgraph.New()
+
+	for name := range sweepers {
+		all.AddNode(name)
+	}
+
+	for name, s := range sweepers {
+		for _, dependency := range s.Dependencies {
+			// An unregistered dependency remains in the graph and fails when run, skipping its dependents.
+			all.AddNode(dependency)
+
+			if err := all.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var names []string
+	for name := range strings.SplitSeq(strings.ToLower(filter), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		return all, nil
+	}
+
+	g := depgraph.New()
+	for name := range sweepers {
+		if !containsAny(strings.ToLower(name), names) {
+			continue
+		}
+
+		dependencies, err := all.DependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, n := range append(dependencies, name) {
+			g.AddNode(n)
+		}
+	}
+
+	for _, from := range g.Nodes() {
+		dependencies, err := all.DirectDependenciesOf(from)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, to := range dependencies {
+			if err := g.AddDependency(from, to); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g, nil
+}
+
+func containsAny(s string, substrs []string) bool {
+	for _, substr := range substrs {
+		if strings.Contains(s, substr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,275 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// setTestSweepers replaces the registered sweepers for the duration of the test.
+func setTestSweepers(t *testing.T, s map[string]*resource.Sweeper, o map[string]bool) {
+	t.Helper()
+
+	oldSweepers, oldOrchestrated := sweepers, orchestrated
+	t.Cleanup(func() {
+		sweepers, orchestrated = oldSweepers, oldOrchestrated
+	})
+
+	sweepers, orchestrated = s, o
+}
+
+func TestSweeperGraph(t *testing.T) {
+	// a <- b <- c, d <- e, f (no dependencies), g <- unregistered.
+	setTestSweepers(t, map[string]*resource.Sweeper{
+		"aws_a": {Name: "aws_a"},
+		"aws_b": {Name: "aws_b", Dependencies: []string{"aws_a"}},
+		"aws_c": {Name: "aws_c", Dependencies: []string{"aws_b"}},
+		"aws_d": {Name: "aws_d"},
+		"aws_e": {Name: "aws_e", Dependencies: []string{"aws_d"}},
+		"aws_f": {Name: "aws_f"},
+		"aws_g": {Name: "aws_g", Dependencies: []string{"aws_unregistered"}},
+	}, map[string]bool{})
+
+	testCases := map[string]struct {
+		filter       string
+		expected     []string
+		dependencies map[string][]string
+	}{
+		"no filter": {
+			expected: []string{"aws_a", "aws_b", "aws_c", "aws_d", "aws_e", "aws_f", "aws_g", "aws_unregistered"},
+		},
+		"empty filter values": {
+			filter:   " , ",
+			expected: []string{"aws_a", "aws_b", "aws_c", "aws_d", "aws_e", "aws_f", "aws_g", "aws_unregistered"},
+		},
+		"no dependencies": {
+			filter:   "aws_f",
+			expected: []string{"aws_f"},
+		},
+		"transitive dependencies": {
+			filter:   "aws_c",
+			expected: []string{"aws_a", "aws_b", "aws_c"},
+			dependencies: map[string][]string{
+				"aws_b": {"aws_a"},
+				"aws_c": {"aws_b"},
+			},
+		},
+		"multiple case insensitive": {
+			filter:   "AWS_B, aws_e",
+			expected: []string{"aws_a", "aws_b", "aws_d", "aws_e"},
+			dependencies: map[string][]string{
+				"aws_b": {"aws_a"},
+				"aws_e": {"aws_d"},
+			},
+		},
+		"unregistered dependency": {
+			filter:   "aws_g",
+			expected: []string{"aws_g", "aws_unregistered"},
+			dependencies: map[string][]string{
+				"aws_g": {"aws_unregistered"},
+			},
+		},
+		"no matches": {
+			filter: "aws_z",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			g, err := sweeperGraph(testCase.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := slices.Sorted(slices.Values(g.Nodes()))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			for from, expected := range testCase.dependencies {
+				got, err := g.DirectDependenciesOf(from)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(got, expected); diff != "" {
+					t.Errorf("unexpected %s dependencies diff (+wanted, -got): %s", from, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestSweeperGraph_dependencyCycle(t *testing.T) {
+	setTestSweepers(t, map[string]*resource.Sweeper{
+		"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+		"aws_b": {Name: "aws_b", Dependencies: []string{"aws_a"}},
+	}, map[string]bool{})
+
+	if _, err := sweeperGraph("aws_a"); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestRunSweepers(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	testCases := map[string]struct {
+		allowFailures bool
+		dryRun        string
+		namePrefixes  string
+		expectedRun   []string
+		expectError   bool
+	}{
+		"failure": {
+			expectedRun: []string{"aws_a", "aws_b", "aws_failing", "aws_orchestrated"},
+			expectError: true,
+		},
+		"allow failures": {
+			allowFailures: true,
+			expectedRun:   []string{"aws_a", "aws_b", "aws_dependent", "aws_failing", "aws_orchestrated"},
+			expectError:   true,
+		},
+		"dry run": {
+			dryRun:      "1",
+			expectedRun: []string{"aws_orchestrated"},
+		},
+		"name prefixes": {
+			namePrefixes: "tf-acc-test",
+			expectedRun:  []string{"aws_orchestrated"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(envvar.SweepDryRun, testCase.dryRun)
+			t.Setenv(envvar.SweepNamePrefixes, testCase.namePrefixes)
+			t.Setenv(envvar.SweepTags, "")
+			t.Setenv(envvar.SweepParallelism, "")
+
+			var (
+				run   []string
+				mutex sync.Mutex
+			)
+			sweeper := func(name string, err error, dependencies ...string) *resource.Sweeper {
+				return &resource.Sweeper{
+					Name: name,
+					F: func(string) error {
+						mutex.Lock()
+						defer mutex.Unlock()
+						run = append(run, name)
+
+						return err
+					},
+					Dependencies: dependencies,
+				}
+			}
+
+			setTestSweepers(t, map[string]*resource.Sweeper{
+				"aws_a":            sweeper("aws_a", nil),
+				"aws_b":            sweeper("aws_b", nil, "aws_a"),
+				"aws_failing":      sweeper("aws_failing", errFailed),
+				"aws_dependent":    sweeper("aws_dependent", nil, "aws_failing"),
+				"aws_orchestrated": sweeper("aws_orchestrated", nil, "aws_a"),
+			}, map[string]bool{
+				"aws_orchestrated": true,
+			})
+
+			err := RunSweepers(context.Background(), []string{"us-west-2"}, "", testCase.allowFailures) //lintignore:AWSAT003
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("RunSweepers() err %t, want %t: %v", got, want, err)
+			}
+
+			if err != nil && !errors.Is(err, errFailed) {
+				t.Errorf("RunSweepers() err = %v, want %v", err, errFailed)
+			}
+
+			if diff := cmp.Diff(slices.Sorted(slices.Values(run)), testCase.expectedRun); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunSweepers_sharedRegionalSweepClient(t *testing.T) {
+	t.Setenv(envvar.SweepDryRun, "")
+	t.Setenv(envvar.SweepNamePrefixes, "")
+	t.Setenv(envvar.SweepTags, "")
+	t.Setenv(envvar.SweepParallelism, "8")
+
+	oldClients, oldNewClient := sweeperClients, newSweeperClient
+	t.Cleanup(func() {
+		sweeperClients, newSweeperClient = oldClients, oldNewClient
+	})
+
+	var (
+		configured = make(map[string]int)
+		mutex      sync.Mutex
+	)
+	sweeperClients = make(map[string]*sweeperClient)
+	newSweeperClient = func(_ context.Context, region string) (*conns.AWSClient, error) {
+		// Configuring a client takes a while, so concurrent sweepers all miss the cache.
+		time.Sleep(10 * time.Millisecond)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		configured[region]++
+
+		return new(conns.AWSClient), nil
+	}
+
+	// Independent sweepers are run concurrently, each getting the shared client for its Region.
+	clients := make(map[string]map[*conns.AWSClient]bool)
+	s := make(map[string]*resource.Sweeper)
+	for i := range 16 {
+		name := fmt.Sprintf("aws_%02d", i)
+		s[name] = &resource.Sweeper{
+			Name: name,
+			F: func(region string) error {
+				client, err := SharedRegionalSweepClient(context.Background(), region)
+				if err != nil {
+					return err
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				if clients[region] == nil {
+					clients[region] = make(map[*conns.AWSClient]bool)
+				}
+				clients[region][client] = true
+
+				return nil
+			},
+		}
+	}
+	setTestSweepers(t, s, map[string]bool{})
+
+	regions := []string{"us-west-2", "us-east-1"} //lintignore:AWSAT003
+	if err := RunSweepers(context.Background(), regions, "", false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, region := range regions {
+		if got, want := configured[region], 1; got != want {
+			t.Errorf("client for region %s configured %d times, want %d", region, got, want)
+		}
+
+		if got, want := len(clients[region]), 1; got != want {
+			t.Errorf("sweepers for region %s got %d clients, want %d", region, got, want)
+		}
+	}
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -39,6 +41,29 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+// Inspect returns the ID, name and tags of the resource to be deleted, as known to the sweeper.
+// Only attributes set by the sweeper are returned; the resource is not read.
+// As most sweepers only set the ID, the name is usually empty and the tags are usually nil,
+// in which case the resource is matched on its ID by name prefix filters and never matched by tag filters.
+func (sr *sweepResource) Inspect(context.Context) (string, string, map[string]string) {
+	var name string
+	var tags map[string]string
+
+	schema := sr.resource.SchemaMap()
+
+	if _, ok := schema[names.AttrName]; ok {
+		name, _ = sr.d.Get(names.AttrName).(string)
+	}
+
+	if _, ok := schema[names.AttrTags]; ok {
+		if v, ok := sr.d.Get(names.AttrTags).(map[string]any); ok && len(v) > 0 {
+			tags = flex.ExpandStringValueMap(v)
+		}
+	}
+
+	return sr.d.Id(), name, tags
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
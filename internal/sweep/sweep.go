@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -27,16 +28,54 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // ServicePackages is set in TestMain in order to break an import cycle.
 var ServicePackages []conns.ServicePackage
 
-// sweeperClients is a shared cache of regional conns.AWSClient
-// This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+// sweeperClient is a lazily configured regional conns.AWSClient.
+type sweeperClient struct {
+	mutex  sync.Mutex
+	client *conns.AWSClient
+}
+
+var (
+	// sweeperClients is a shared cache of regional conns.AWSClient, keyed by Region.
+	// This prevents client re-initialization for every resource with no benefit.
+	sweeperClients      = make(map[string]*sweeperClient)
+	sweeperClientsMutex sync.Mutex
+
+	// newSweeperClient configures a conns.AWSClient for a Region. It is a variable so that tests can replace it.
+	newSweeperClient = configureSweeperClient
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
+// It is safe for concurrent use; the client for each Region is configured once and then reused.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
-	if client, ok := sweeperClients[region]; ok {
-		return client, nil
+	sweeperClientsMutex.Lock()
+	c, ok := sweeperClients[region]
+	if !ok {
+		c = &sweeperClient{}
+		sweeperClients[region] = c
+	}
+	sweeperClientsMutex.Unlock()
+
+	// Concurrent callers for the same Region wait for the first to configure the client.
+	// A failed configuration is not cached, so the next caller tries again.
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.client != nil {
+		return c.client, nil
+	}
+
+	client, err := newSweeperClient(ctx, region)
+	if err != nil {
+		return nil, err
 	}
 
+	c.client = client
+
+	return client, nil
+}
+
+// configureSweeperClient configures a conns.AWSClient for a Region from environment variables.
+func configureSweeperClient(ctx context.Context, region string) (*conns.AWSClient, error) {
 	_, _, err := envvar.RequireOneOf([]string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running sweepers")
 	if err != nil {
 		return nil, err
@@ -95,8 +134,6 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		return nil, fmt.Errorf("getting AWS client: %#v", diags)
 	}
 
-	sweeperClients[region] = client
-
 	return client, nil
 }
 
@@ -104,11 +141,29 @@ type Sweepable interface {
 	Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator deletes the specified resources concurrently.
+// Any name prefix or tag filter configured via environment variables is applied first and,
+// in dry-run mode, the resources that would be deleted are only logged.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	filter, err := filterFromEnv()
+	if err != nil {
+		return err
+	}
+
+	sweepables = filter.apply(ctx, sweepables)
+
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	if filter.dryRun {
+		for _, sweepable := range sweepables {
+			tflog.Info(inspectContext(ctx, sweepable), "Dry run, skipping resource deletion")
+		}
+
+		return nil
+	}
+
 	var g tfsync.Group
 
 	for _, sweepable := range sweepables {
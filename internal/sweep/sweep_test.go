@@ -5,6 +5,10 @@ package sweep_test
 
 import (
 	"context"
+	"flag"
+	"log"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -18,5 +22,20 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	// The -sweep, -sweep-run and -sweep-allow-failures flags are defined by terraform-plugin-testing.
+	// Sweepers are run here rather than by resource.TestMain so that they can be scheduled from a dependency graph.
+	flag.Parse()
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" {
+		filter := flag.Lookup("sweep-run").Value.String()
+		allowFailures := flag.Lookup("sweep-allow-failures").Value.String() == "true"
+
+		if err := sweep.RunSweepers(ctx, strings.Split(regions, ","), filter, allowFailures); err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
 	resource.TestMain(m)
 }
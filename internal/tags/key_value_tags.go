@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/url"
 	"reflect"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -58,12 +59,42 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+
+	// Rules contains tags to default across a subset of resources. The tags of
+	// each rule matching a resource are merged, in order, over Tags.
+	Rules []DefaultTagsRule
+}
+
+// DefaultTagsRule contains tags to default across the resources of matching
+// resource types or service packages.
+type DefaultTagsRule struct {
+	Tags KeyValueTags
+
+	// IncludeResourceTypes and IncludeServices restrict the rule to the given
+	// Terraform resource types and service packages. When both are empty, the
+	// rule matches all resources.
+	IncludeResourceTypes []string
+	IncludeServices      []string
+
+	// ExcludeResourceTypes and ExcludeServices exclude the given Terraform
+	// resource types and service packages from the rule.
+	ExcludeResourceTypes []string
+	ExcludeServices      []string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
-	Keys        KeyValueTags
-	KeyPrefixes KeyValueTags
+	Keys          KeyValueTags
+	KeyPrefixes   KeyValueTags
+	KeySuffixes   KeyValueTags
+	KeyRegexes    []*regexp.Regexp
+	ValuePatterns []IgnoreValuePattern
+}
+
+// IgnoreValuePattern removes the tag with the given key only when its value matches.
+type IgnoreValuePattern struct {
+	Key   string
+	Value *regexp.Regexp
 }
 
 // TagPolicyConfig contains options related to organizational tagging policies.
@@ -78,6 +109,21 @@ type TagPolicyConfig struct {
 	// RequiredTags is a mapping of Terraform resource type names to the required
 	// tags defined in the effective tag policy
 	RequiredTags map[string]KeyValueTags
+
+	// Rules is a mapping of Terraform resource type names to the tag key
+	// capitalization and allowed values enforced by the effective tag policy
+	Rules map[string][]TagPolicyRule
+}
+
+// TagPolicyRule contains the compliance rules for a single tag key defined in
+// an organizational tag policy.
+type TagPolicyRule struct {
+	// Key is the tag key with the capitalization required by the policy.
+	Key string
+
+	// Values are the allowed tag values. Each value may contain a single "*"
+	// wildcard. When empty, any value is allowed.
+	Values []string
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -125,6 +171,97 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	return dc.Tags.ContainsAll(tags)
 }
 
+// ForResource returns the DefaultConfig applying to resources of the given
+// Terraform resource type and service package, with the tags of matching
+// rules merged in order
+func (dc *DefaultConfig) ForResource(typeName, servicePackageName string) *DefaultConfig {
+	if dc == nil || len(dc.Rules) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags
+	for _, rule := range dc.Rules {
+		if rule.matches(typeName, servicePackageName) {
+			tags = tags.Merge(rule.Tags)
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
+
+// matches returns whether the rule applies to resources of the given Terraform
+// resource type and service package.
+func (r DefaultTagsRule) matches(typeName, servicePackageName string) bool {
+	if slices.Contains(r.ExcludeResourceTypes, typeName) || slices.Contains(r.ExcludeServices, servicePackageName) {
+		return false
+	}
+
+	if len(r.IncludeResourceTypes) == 0 && len(r.IncludeServices) == 0 {
+		return true
+	}
+
+	return slices.Contains(r.IncludeResourceTypes, typeName) || slices.Contains(r.IncludeServices, servicePackageName)
+}
+
+// NonCompliant returns a description of each of the given tags whose key
+// capitalization or value does not comply with the tag policy rules for the
+// given Terraform resource type, ordered by tag key
+func (tpc *TagPolicyConfig) NonCompliant(typeName string, tags KeyValueTags) []string {
+	if tpc == nil {
+		return nil
+	}
+
+	rules := tpc.Rules[typeName]
+	if len(rules) == 0 {
+		return nil
+	}
+
+	var result []string
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		for _, rule := range rules {
+			// Tag policies match tag keys case-insensitively.
+			if !strings.EqualFold(k, rule.Key) {
+				continue
+			}
+
+			if k != rule.Key {
+				result = append(result, fmt.Sprintf("%q must be capitalized as %q", k, rule.Key))
+			}
+
+			if v := tags.KeyValue(k); v != nil && !rule.allows(*v) {
+				result = append(result, fmt.Sprintf("%q value %q is not one of %q", k, *v, rule.Values))
+			}
+		}
+	}
+
+	return result
+}
+
+// allows returns whether the given value is allowed by the rule.
+func (r TagPolicyRule) allows(value string) bool {
+	if len(r.Values) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(r.Values, func(pattern string) bool {
+		prefix, suffix, ok := strings.Cut(pattern, "*")
+		if !ok {
+			return value == pattern
+		}
+
+		return len(value) >= len(prefix)+len(suffix) && strings.HasPrefix(value, prefix) && strings.HasSuffix(value, suffix)
+	})
+}
+
 // IgnoreAWS returns non-AWS tag keys.
 func (tags KeyValueTags) IgnoreAWS() KeyValueTags { // nosemgrep:ci.aws-in-func-name
 	result := make(KeyValueTags)
@@ -145,6 +282,9 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	}
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
+	result = result.IgnoreSuffixes(config.KeySuffixes)
+	result = result.IgnoreRegexes(config.KeyRegexes)
+	result = result.IgnoreValuePatterns(config.ValuePatterns)
 	result = result.Ignore(config.Keys)
 
 	return result
@@ -197,6 +337,64 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreRegexes returns tag keys not matching any of the regular expressions.
+func (tags KeyValueTags) IgnoreRegexes(ignoreTagRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(ignoreTagRegexes, func(re *regexp.Regexp) bool {
+			return re.MatchString(k)
+		}) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreSuffixes returns non-matching tag key suffixes.
+func (tags KeyValueTags) IgnoreSuffixes(ignoreTagSuffixes KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for ignoreTagSuffix := range ignoreTagSuffixes {
+			if strings.HasSuffix(k, ignoreTagSuffix) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreValuePatterns returns tags whose key and value do not both match any of the patterns.
+func (tags KeyValueTags) IgnoreValuePatterns(ignoreValuePatterns []IgnoreValuePattern) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(ignoreValuePatterns, func(p IgnoreValuePattern) bool {
+			return p.Key == k && p.Value != nil && p.Value.MatchString(v.ValueString())
+		}) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	result := make(KeyValueTags)
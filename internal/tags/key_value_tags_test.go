@@ -5,9 +5,11 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"slices"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -315,6 +317,230 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Owner": "platform",
+		}),
+		Rules: []DefaultTagsRule{
+			{
+				Tags: New(ctx, map[string]string{
+					"CostCenter": "100",
+				}),
+				IncludeServices: []string{"ec2", "rds"},
+			},
+			{
+				Tags: New(ctx, map[string]string{
+					"CostCenter": "200",
+				}),
+				IncludeResourceTypes: []string{"aws_db_instance"},
+			},
+			{
+				Tags: New(ctx, map[string]string{
+					"Owner": "security",
+				}),
+				ExcludeServices:      []string{"iam"},
+				ExcludeResourceTypes: []string{"aws_route53_record"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		typeName           string
+		servicePackageName string
+		want               KeyValueTags
+	}{
+		{
+			name:               "nil config",
+			typeName:           "aws_instance",
+			servicePackageName: "ec2",
+		},
+		{
+			name: "no rules",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Owner": "platform",
+				}),
+			},
+			typeName:           "aws_instance",
+			servicePackageName: "ec2",
+			want: New(ctx, map[string]string{
+				"Owner": "platform",
+			}),
+		},
+		{
+			name:               "included service",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_instance",
+			servicePackageName: "ec2",
+			want: New(ctx, map[string]string{
+				"CostCenter": "100",
+				"Owner":      "security",
+			}),
+		},
+		{
+			name:               "rules merged in order",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_db_instance",
+			servicePackageName: "rds",
+			want: New(ctx, map[string]string{
+				"CostCenter": "200",
+				"Owner":      "security",
+			}),
+		},
+		{
+			name:               "excluded service",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_iam_role",
+			servicePackageName: "iam",
+			want: New(ctx, map[string]string{
+				"Owner": "platform",
+			}),
+		},
+		{
+			name:               "excluded resource type",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_route53_record",
+			servicePackageName: "route53",
+			want: New(ctx, map[string]string{
+				"Owner": "platform",
+			}),
+		},
+		{
+			name: "no matching tags",
+			defaultConfig: &DefaultConfig{
+				Rules: []DefaultTagsRule{
+					{
+						Tags: New(ctx, map[string]string{
+							"CostCenter": "100",
+						}),
+						IncludeServices: []string{"ec2"},
+					},
+				},
+			},
+			typeName:           "aws_iam_role",
+			servicePackageName: "iam",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.typeName, testCase.servicePackageName).GetTags()
+
+			if !got.Equal(testCase.want) {
+				t.Errorf("got %v; want %v", got.Map(), testCase.want.Map())
+			}
+		})
+	}
+}
+
+func TestTagPolicyConfigNonCompliant(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	config := &TagPolicyConfig{
+		Rules: map[string][]TagPolicyRule{
+			"aws_test": {
+				{Key: "CostCenter", Values: []string{"100", "200*"}},
+				{Key: "Owner", Values: []string{"*@example.com"}},
+				{Key: "Project"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		config   *TagPolicyConfig
+		typeName string
+		tags     KeyValueTags
+		want     []string
+	}{
+		{
+			name:     "nil config",
+			typeName: "aws_test",
+			tags: New(ctx, map[string]string{
+				"costcenter": "999",
+			}),
+		},
+		{
+			name:     "no rules for type",
+			config:   config,
+			typeName: "aws_other",
+			tags: New(ctx, map[string]string{
+				"costcenter": "999",
+			}),
+		},
+		{
+			name:     "compliant",
+			config:   config,
+			typeName: "aws_test",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "2001",
+				"Owner":      "jdoe@example.com",
+				"Project":    "anything",
+				"Other":      "value",
+			}),
+		},
+		{
+			name:     "key capitalization",
+			config:   config,
+			typeName: "aws_test",
+			tags: New(ctx, map[string]string{
+				"costcenter": "100",
+				"PROJECT":    "anything",
+			}),
+			want: []string{
+				`"PROJECT" must be capitalized as "Project"`,
+				`"costcenter" must be capitalized as "CostCenter"`,
+			},
+		},
+		{
+			name:     "values",
+			config:   config,
+			typeName: "aws_test",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "300",
+				"Owner":      "jdoe@example.org",
+			}),
+			want: []string{
+				`"CostCenter" value "300" is not one of ["100" "200*"]`,
+				`"Owner" value "jdoe@example.org" is not one of ["*@example.com"]`,
+			},
+		},
+		{
+			name:     "key capitalization and value",
+			config:   config,
+			typeName: "aws_test",
+			tags: New(ctx, map[string]string{
+				"costCenter": "1000",
+			}),
+			want: []string{
+				`"costCenter" must be capitalized as "CostCenter"`,
+				`"costCenter" value "1000" is not one of ["100" "200*"]`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.NonCompliant(testCase.typeName, testCase.tags)
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("got %q; want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -565,6 +791,92 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key suffixes",
+			tags: New(ctx, map[string]string{
+				"key1-backup":   "value1",
+				"key2":          "value2",
+				"key3-snapshot": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeySuffixes: New(ctx, []string{
+					"-backup",
+					"-snapshot",
+				}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "key regexes",
+			tags: New(ctx, map[string]string{
+				"aws-controltower:key1": "value1",
+				"key2":                  "value2",
+				"Key3":                  "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^aws-controltower:`),
+					regexache.MustCompile(`^[A-Z]`),
+				},
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "value patterns",
+			tags: New(ctx, map[string]string{
+				"LastScanned": "2026-10-18T12:00:00Z",
+				"LastPatched": "never",
+				"key3":        "2026-10-18T12:00:00Z",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				ValuePatterns: []IgnoreValuePattern{
+					{
+						Key:   "LastScanned",
+						Value: regexache.MustCompile(`^\d{4}-\d{2}-\d{2}T`),
+					},
+					{
+						Key:   "LastPatched",
+						Value: regexache.MustCompile(`^\d{4}-\d{2}-\d{2}T`),
+					},
+				},
+			},
+			want: map[string]string{
+				"LastPatched": "never",
+				"key3":        "2026-10-18T12:00:00Z",
+			},
+		},
+		{
+			name: "all options",
+			tags: New(ctx, map[string]string{
+				"key1":        "value1",
+				"prefix-key2": "value2",
+				"key3-suffix": "value3",
+				"regex-key4":  "value4",
+				"key5":        "volatile",
+				"key6":        "value6",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New(ctx, []string{"key1"}),
+				KeyPrefixes: New(ctx, []string{"prefix-"}),
+				KeySuffixes: New(ctx, []string{"-suffix"}),
+				KeyRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^regex-`),
+				},
+				ValuePatterns: []IgnoreValuePattern{
+					{
+						Key:   "key5",
+						Value: regexache.MustCompile(`^volatile$`),
+					},
+				},
+			},
+			want: map[string]string{
+				"key6": "value6",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// ErrEffectivePolicyAccessDenied is returned by GetRules when the calling principal
+// is not permitted to describe the effective tag policy
+var ErrEffectivePolicyAccessDenied = errors.New("access denied describing effective tag policy")
+
+// effectivePolicy is the content of an effective tag policy
+//
+// Inheritance operators are resolved in effective policies, so each field
+// holds its final value.
+type effectivePolicy struct {
+	Tags map[string]effectivePolicyTag `json:"tags"`
+}
+
+type effectivePolicyTag struct {
+	TagKey      string   `json:"tag_key"`
+	TagValue    []string `json:"tag_value"`
+	EnforcedFor []string `json:"enforced_for"`
+}
+
+// GetRules returns the tag key capitalization and allowed value rules defined in
+// the effective tag policy for the calling account, keyed by Terraform resource type
+func GetRules(ctx context.Context, awsConfig aws.Config) (map[string][]tftags.TagPolicyRule, error) {
+	client := organizations.NewFromConfig(awsConfig)
+	output, err := client.DescribeEffectivePolicy(ctx, &organizations.DescribeEffectivePolicyInput{
+		PolicyType: types.EffectivePolicyTypeTagPolicy,
+	})
+
+	// No tag policy applies to the account.
+	if errs.IsA[*types.EffectivePolicyNotFoundException](err) || errs.IsA[*types.AWSOrganizationsNotInUseException](err) {
+		return nil, nil
+	}
+
+	if errs.IsA[*types.AccessDeniedException](err) {
+		return nil, fmt.Errorf("%w: %w", ErrEffectivePolicyAccessDenied, err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.EffectivePolicy == nil {
+		return nil, nil
+	}
+
+	return parseRules(aws.ToString(output.EffectivePolicy.PolicyContent))
+}
+
+// parseRules translates the content of an effective tag policy into a map of
+// rules per Terraform resource type
+//
+// Rules only apply to the resource types listed in a tag's "enforced_for"
+// field, matching the resource types for which AWS prevents non-compliant
+// tagging operations.
+func parseRules(content string) (map[string][]tftags.TagPolicyRule, error) {
+	var policy effectivePolicy
+	if err := json.Unmarshal([]byte(content), &policy); err != nil {
+		return nil, fmt.Errorf("parsing effective tag policy: %w", err)
+	}
+
+	m := make(map[string][]tftags.TagPolicyRule)
+	for _, name := range slices.Sorted(maps.Keys(policy.Tags)) {
+		tag := policy.Tags[name]
+
+		rule := tftags.TagPolicyRule{
+			Key:    tag.TagKey,
+			Values: tag.TagValue,
+		}
+		if rule.Key == "" {
+			rule.Key = name
+		}
+
+		for _, tfType := range enforcedForTypes(tag.EnforcedFor) {
+			m[tfType] = append(m[tfType], rule)
+		}
+	}
+
+	return m, nil
+}
+
+// enforcedForTypes returns the Terraform resource types corresponding to the
+// resource types in a tag policy "enforced_for" field
+//
+// Entries are either "service:resource-type", or "service:*" and
+// "service:ALL_SUPPORTED" for all supported resource types of a service.
+func enforcedForTypes(enforcedFor []string) []string {
+	var tfTypes []string
+	for _, v := range enforcedFor {
+		service, resourceType, _ := strings.Cut(v, ":")
+
+		switch resourceType {
+		case "*", "ALL_SUPPORTED":
+			for _, k := range slices.Sorted(maps.Keys(Lookup)) {
+				if strings.HasPrefix(k, service+":") {
+					tfTypes = append(tfTypes, Lookup[k]...)
+				}
+			}
+		default:
+			tfTypes = append(tfTypes, Lookup[v]...)
+		}
+	}
+
+	slices.Sort(tfTypes)
+	return slices.Compact(tfTypes)
+}
@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestParseRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		content     string
+		want        map[string][]tftags.TagPolicyRule
+		expectError bool
+	}{
+		{
+			name:    "no tags",
+			content: `{}`,
+			want:    map[string][]tftags.TagPolicyRule{},
+		},
+		{
+			name: "not enforced",
+			content: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": "CostCenter",
+      "tag_value": ["100", "200"]
+    }
+  }
+}`,
+			want: map[string][]tftags.TagPolicyRule{},
+		},
+		{
+			name: "enforced for resource types",
+			content: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": "CostCenter",
+      "tag_value": ["100", "200*"],
+      "enforced_for": ["acm:certificate", "athena:workgroup"]
+    },
+    "project": {
+      "tag_key": "Project",
+      "enforced_for": ["acm:certificate"]
+    }
+  }
+}`,
+			want: map[string][]tftags.TagPolicyRule{
+				"aws_acm_certificate": {
+					{Key: "CostCenter", Values: []string{"100", "200*"}},
+					{Key: "Project"},
+				},
+				"aws_athena_workgroup": {
+					{Key: "CostCenter", Values: []string{"100", "200*"}},
+				},
+			},
+		},
+		{
+			name: "enforced for all resource types of a service",
+			content: `{
+  "tags": {
+    "owner": {
+      "tag_key": "Owner",
+      "enforced_for": ["athena:*"]
+    }
+  }
+}`,
+			want: map[string][]tftags.TagPolicyRule{
+				"aws_athena_capacity_reservation": {{Key: "Owner"}},
+				"aws_athena_data_catalog":         {{Key: "Owner"}},
+				"aws_athena_workgroup":            {{Key: "Owner"}},
+			},
+		},
+		{
+			name: "unknown resource type",
+			content: `{
+  "tags": {
+    "owner": {
+      "tag_key": "Owner",
+      "enforced_for": ["unknown:thing"]
+    }
+  }
+}`,
+			want: map[string][]tftags.TagPolicyRule{},
+		},
+		{
+			name:        "invalid JSON",
+			content:     `{`,
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseRules(testCase.content)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("parseRules() error = %v, expectError %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		statusCode       int
+		body             string
+		want             map[string][]tftags.TagPolicyRule
+		wantAccessDenied bool
+		expectError      bool
+	}{
+		{
+			name:       "effective policy",
+			statusCode: http.StatusOK,
+			body:       `{"EffectivePolicy":{"PolicyContent":"{\"tags\":{\"costcenter\":{\"tag_key\":\"CostCenter\",\"enforced_for\":[\"s3:bucket\"]}}}"}}`,
+			want: map[string][]tftags.TagPolicyRule{
+				"aws_s3_bucket": {{Key: "CostCenter"}},
+			},
+		},
+		{
+			name:       "no effective policy",
+			statusCode: http.StatusBadRequest,
+			body:       `{"__type":"EffectivePolicyNotFoundException","Message":"not found"}`,
+		},
+		{
+			name:             "access denied",
+			statusCode:       http.StatusBadRequest,
+			body:             `{"__type":"AccessDeniedException","Message":"denied"}`,
+			wantAccessDenied: true,
+			expectError:      true,
+		},
+		{
+			name:        "other error",
+			statusCode:  http.StatusBadRequest,
+			body:        `{"__type":"InvalidInputException","Message":"invalid"}`,
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+				w.WriteHeader(testCase.statusCode)
+				w.Write([]byte(testCase.body)) //nolint:errcheck // test server
+			}))
+			t.Cleanup(server.Close)
+
+			awsConfig := aws.Config{
+				BaseEndpoint:     aws.String(server.URL),
+				Credentials:      credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+				Region:           "us-east-1", //lintignore:AWSAT003
+				RetryMaxAttempts: 1,
+			}
+
+			got, err := GetRules(t.Context(), awsConfig)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("GetRules() error = %v, expectError %t", err, want)
+			}
+
+			if got, want := errors.Is(err, ErrEffectivePolicyAccessDenied), testCase.wantAccessDenied; got != want {
+				t.Errorf("GetRules() error = %v, access denied %t, want %t", err, got, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package s3uri parses S3 URIs and the S3 ARNs that they correspond to:
+//
+//   - s3://bucket[/key]
+//   - s3://access-point-arn[/key]
+//   - arn:partition:s3:::bucket[/key]
+//   - arn:partition:s3:region:account-id:accesspoint/name[/object/key]
+//   - arn:partition:s3-object-lambda:region:account-id:accesspoint/name[/object/key]
+//
+// See https://docs.aws.amazon.com/service-authorization/latest/reference/list_amazons3.html#amazons3-resources-for-iam-policies.
+package s3uri
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+const (
+	Scheme = "s3://"
+
+	arnService             = "s3"
+	objectLambdaARNService = "s3-object-lambda"
+	accessPointPrefix      = "accesspoint/"
+	accessPointObjectInfix = "/object/"
+)
+
+var (
+	bucketNameRegexp  = regexache.MustCompile(`^[a-z0-9][\.\-a-z0-9]{1,61}[a-z0-9]$`)
+	accessPointRegexp = regexache.MustCompile(`^[a-z0-9][\-a-z0-9]{1,48}[a-z0-9]$`)
+)
+
+// Location is an S3 bucket or access point, optionally with an object key.
+// The partition, region and account ID are only set for access points.
+type Location struct {
+	Partition    string
+	Region       string
+	AccountID    string
+	Bucket       string
+	AccessPoint  string
+	ObjectLambda bool
+	Key          string
+}
+
+// Parse parses an S3 URI of the form s3://bucket[/key] or s3://access-point-arn[/key].
+func Parse(uri string) (Location, error) {
+	var location Location
+
+	s, ok := strings.CutPrefix(uri, Scheme)
+	if !ok {
+		return location, fmt.Errorf(`S3 URI must begin with "%s"`, Scheme)
+	}
+
+	if strings.HasPrefix(s, "arn:") {
+		// The access point name is the segment following "accesspoint/". Anything after it is the key.
+		i := strings.Index(s, accessPointPrefix)
+		if i == -1 {
+			return location, errors.New("S3 URI ARN must be an access point ARN")
+		}
+
+		var key string
+		if j := strings.Index(s[i+len(accessPointPrefix):], "/"); j != -1 {
+			j += i + len(accessPointPrefix)
+			s, key = s[:j], s[j+1:]
+		}
+
+		location, err := parseAccessPointARN(s)
+		if err != nil {
+			return location, err
+		}
+		location.Key = key
+
+		return location, nil
+	}
+
+	location.Bucket, location.Key, _ = strings.Cut(s, "/")
+	if !bucketNameRegexp.MatchString(location.Bucket) {
+		return location, fmt.Errorf("invalid S3 bucket name: %q", location.Bucket)
+	}
+
+	return location, nil
+}
+
+// ParseARN parses an S3 bucket, object, access point or access point object ARN.
+func ParseARN(s string) (Location, error) {
+	var location Location
+
+	v, err := arn.Parse(s)
+	if err != nil {
+		return location, err
+	}
+
+	if err := ValidatePartition(v.Partition); err != nil {
+		return location, err
+	}
+
+	switch v.Service {
+	case arnService:
+		if v.Region == "" && v.AccountID == "" {
+			location.Partition = v.Partition
+			location.Bucket, location.Key, _ = strings.Cut(v.Resource, "/")
+			if !bucketNameRegexp.MatchString(location.Bucket) {
+				return location, fmt.Errorf("invalid S3 bucket name: %q", location.Bucket)
+			}
+
+			return location, nil
+		}
+	case objectLambdaARNService:
+	default:
+		return location, fmt.Errorf(`service must be "%s" or "%s"`, arnService, objectLambdaARNService)
+	}
+
+	resource, key, _ := strings.Cut(v.Resource, accessPointObjectInfix)
+	v.Resource = resource
+
+	location, err = parseAccessPointARN(v.String())
+	if err != nil {
+		return location, err
+	}
+	location.Key = key
+
+	return location, nil
+}
+
+func parseAccessPointARN(s string) (Location, error) {
+	var location Location
+
+	v, err := arn.Parse(s)
+	if err != nil {
+		return location, err
+	}
+
+	if err := ValidatePartition(v.Partition); err != nil {
+		return location, err
+	}
+
+	switch v.Service {
+	case arnService:
+	case objectLambdaARNService:
+		location.ObjectLambda = true
+	default:
+		return location, fmt.Errorf(`service must be "%s" or "%s"`, arnService, objectLambdaARNService)
+	}
+
+	if v.Region == "" {
+		return location, errors.New("access point ARN region must not be empty")
+	}
+	if v.AccountID == "" {
+		return location, errors.New("access point ARN account ID must not be empty")
+	}
+
+	name, ok := strings.CutPrefix(v.Resource, accessPointPrefix)
+	if !ok {
+		return location, fmt.Errorf(`resource must begin with "%s"`, accessPointPrefix)
+	}
+	if !accessPointRegexp.MatchString(name) {
+		return location, fmt.Errorf("invalid S3 access point name: %q", name)
+	}
+
+	location.Partition = v.Partition
+	location.Region = v.Region
+	location.AccountID = v.AccountID
+	location.AccessPoint = name
+
+	return location, nil
+}
+
+// ValidatePartition returns an error if the specified partition is not a known AWS partition.
+func ValidatePartition(partition string) error {
+	var ids []string
+	for _, p := range endpoints.DefaultPartitions() {
+		if p.ID() == partition {
+			return nil
+		}
+		ids = append(ids, p.ID())
+	}
+	slices.Sort(ids)
+
+	return fmt.Errorf("partition must be one of %s, got %q", strings.Join(ids, ", "), partition)
+}
+
+// ARN returns the ARN of the S3 location in the specified partition.
+// Access point ARNs retain their own partition.
+func (l Location) ARN(partition string) string {
+	if l.AccessPoint == "" {
+		resource := l.Bucket
+		if l.Key != "" {
+			resource += "/" + l.Key
+		}
+
+		return arn.ARN{
+			Partition: partition,
+			Service:   arnService,
+			Resource:  resource,
+		}.String()
+	}
+
+	resource := accessPointPrefix + l.AccessPoint
+	if l.Key != "" {
+		resource += accessPointObjectInfix + l.Key
+	}
+
+	return arn.ARN{
+		Partition: l.Partition,
+		Service:   l.service(),
+		Region:    l.Region,
+		AccountID: l.AccountID,
+		Resource:  resource,
+	}.String()
+}
+
+// URI returns the S3 URI of the location.
+func (l Location) URI() string {
+	var uri string
+
+	if l.AccessPoint == "" {
+		uri = Scheme + l.Bucket
+	} else {
+		uri = Scheme + arn.ARN{
+			Partition: l.Partition,
+			Service:   l.service(),
+			Region:    l.Region,
+			AccountID: l.AccountID,
+			Resource:  accessPointPrefix + l.AccessPoint,
+		}.String()
+	}
+
+	if l.Key != "" {
+		uri += "/" + l.Key
+	}
+
+	return uri
+}
+
+func (l Location) service() string {
+	if l.ObjectLambda {
+		return objectLambdaARNService
+	}
+
+	return arnService
+}
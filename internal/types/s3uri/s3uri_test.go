@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3uri
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input       string
+		expected    Location
+		expectError bool
+	}{
+		"empty": {
+			input:       "",
+			expectError: true,
+		},
+		"no scheme": {
+			input:       "bucket/key",
+			expectError: true,
+		},
+		"bucket": {
+			input:    "s3://bucket",
+			expected: Location{Bucket: "bucket"},
+		},
+		"bucket key": {
+			input:    "s3://bucket/path/to/key",
+			expected: Location{Bucket: "bucket", Key: "path/to/key"},
+		},
+		"invalid bucket": {
+			input:       "s3://asbcdefg--#/key",
+			expectError: true,
+		},
+		"access point": {
+			input: "s3://arn:aws:s3:us-west-2:123456789012:accesspoint/example/path/to/key", //lintignore:AWSAT003,AWSAT005
+			expected: Location{
+				Partition:   "aws",
+				Region:      "us-west-2", //lintignore:AWSAT003
+				AccountID:   "123456789012",
+				AccessPoint: "example",
+				Key:         "path/to/key",
+			},
+		},
+		"object lambda access point": {
+			input: "s3://arn:aws:s3-object-lambda:us-west-2:123456789012:accesspoint/example", //lintignore:AWSAT003,AWSAT005
+			expected: Location{
+				Partition:    "aws",
+				Region:       "us-west-2", //lintignore:AWSAT003
+				AccountID:    "123456789012",
+				AccessPoint:  "example",
+				ObjectLambda: true,
+			},
+		},
+		"bucket ARN": {
+			input:       "s3://arn:aws:s3:::bucket/key", //lintignore:AWSAT005
+			expectError: true,
+		},
+		"invalid partition": {
+			input:       "s3://arn:aws-invalid:s3:us-west-2:123456789012:accesspoint/example", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(testcase.input)
+
+			if err != nil {
+				if !testcase.expectError {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if testcase.expectError {
+				t.Fatal("expected error, got none")
+			}
+
+			if diff := cmp.Diff(testcase.expected, got); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, want := got.URI(), testcase.input; got != want {
+				t.Errorf("URI() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestParseARN(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input       string
+		expected    Location
+		expectError bool
+	}{
+		"empty": {
+			input:       "",
+			expectError: true,
+		},
+		"bucket": {
+			input:    "arn:aws:s3:::bucket", //lintignore:AWSAT005
+			expected: Location{Partition: "aws", Bucket: "bucket"},
+		},
+		"object": {
+			input:    "arn:aws-cn:s3:::bucket/path/to/key", //lintignore:AWSAT005
+			expected: Location{Partition: "aws-cn", Bucket: "bucket", Key: "path/to/key"},
+		},
+		"access point object": {
+			input: "arn:aws:s3:us-west-2:123456789012:accesspoint/example/object/path/to/key", //lintignore:AWSAT003,AWSAT005
+			expected: Location{
+				Partition:   "aws",
+				Region:      "us-west-2", //lintignore:AWSAT003
+				AccountID:   "123456789012",
+				AccessPoint: "example",
+				Key:         "path/to/key",
+			},
+		},
+		"invalid service": {
+			input:       "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+			expectError: true,
+		},
+		"access point no account": {
+			input:       "arn:aws:s3:us-west-2::accesspoint/example", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseARN(testcase.input)
+
+			if err != nil {
+				if !testcase.expectError {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if testcase.expectError {
+				t.Fatal("expected error, got none")
+			}
+
+			if diff := cmp.Diff(testcase.expected, got); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, want := got.ARN(testcase.expected.Partition), testcase.input; got != want {
+				t.Errorf("ARN() = %q, want %q", got, want)
+			}
+		})
+	}
+}
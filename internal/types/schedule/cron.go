@@ -0,0 +1,293 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	monthNames   = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+	weekdayNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+)
+
+// cronExpression is a parsed AWS 6-field cron expression.
+// Day-of-week values are 1 (Sunday) through 7 (Saturday).
+type cronExpression struct {
+	minutes []bool
+	hours   []bool
+	months  []bool
+	years   []bool
+
+	// Exactly one of the day-of-month and day-of-week fields is specified; the other is "?".
+	daysOfMonth      []bool
+	lastDayOfMonth   bool // L
+	lastWeekday      bool // LW
+	nearestWeekdayTo int  // nW
+
+	daysOfWeek          []bool
+	lastDayOfWeek       int // nL
+	nthDayOfWeek        int // n#k
+	nthDayOfWeekOrdinal int
+}
+
+func parseCron(s string) (Expression, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("cron expression %q must have 6 fields (minutes hours day-of-month month day-of-week year), got %d", s, len(fields))
+	}
+
+	var e cronExpression
+	var err error
+
+	if e.minutes, err = parseCronField(fields[0], 0, 59, nil, true); err != nil {
+		return nil, fmt.Errorf("cron expression minutes field: %w", err)
+	}
+	if e.hours, err = parseCronField(fields[1], 0, 23, nil, true); err != nil {
+		return nil, fmt.Errorf("cron expression hours field: %w", err)
+	}
+	if e.months, err = parseCronField(fields[3], 1, 12, monthNames, true); err != nil {
+		return nil, fmt.Errorf("cron expression month field: %w", err)
+	}
+	if e.years, err = parseCronField(fields[5], minYear, maxYear, nil, true); err != nil {
+		return nil, fmt.Errorf("cron expression year field: %w", err)
+	}
+
+	switch dom, dow := fields[2], fields[4]; {
+	case dom == "?" && dow == "?":
+		return nil, errors.New("cron expression day-of-month and day-of-week fields cannot both be ?")
+	case dom != "?" && dow != "?":
+		return nil, errors.New("cron expression day-of-month or day-of-week field must be ?")
+	case dom != "?":
+		if err := e.parseDayOfMonth(dom); err != nil {
+			return nil, fmt.Errorf("cron expression day-of-month field: %w", err)
+		}
+	default:
+		if err := e.parseDayOfWeek(dow); err != nil {
+			return nil, fmt.Errorf("cron expression day-of-week field: %w", err)
+		}
+	}
+
+	return e, nil
+}
+
+func (e *cronExpression) parseDayOfMonth(s string) error {
+	switch {
+	case s == "L":
+		e.lastDayOfMonth = true
+		return nil
+	case s == "LW":
+		e.lastWeekday = true
+		return nil
+	case strings.HasSuffix(s, "W"):
+		v, err := parseCronValue(strings.TrimSuffix(s, "W"), 1, 31, nil)
+		if err != nil {
+			return err
+		}
+		e.nearestWeekdayTo = v
+		return nil
+	}
+
+	var err error
+	e.daysOfMonth, err = parseCronField(s, 1, 31, nil, true)
+
+	return err
+}
+
+func (e *cronExpression) parseDayOfWeek(s string) error {
+	switch {
+	case s == "L":
+		// L alone is the last day of the week (Saturday), not the last Saturday of the month.
+		e.daysOfWeek = make([]bool, 8)
+		e.daysOfWeek[7] = true
+		return nil
+	case strings.HasSuffix(s, "L"):
+		v, err := parseCronValue(strings.TrimSuffix(s, "L"), 1, 7, weekdayNames)
+		if err != nil {
+			return err
+		}
+		e.lastDayOfWeek = v
+		return nil
+	case strings.Contains(s, "#"):
+		day, ordinal, _ := strings.Cut(s, "#")
+		v, err := parseCronValue(day, 1, 7, weekdayNames)
+		if err != nil {
+			return err
+		}
+		n, err := parseCronValue(ordinal, 1, 5, nil)
+		if err != nil {
+			return err
+		}
+		e.nthDayOfWeek, e.nthDayOfWeekOrdinal = v, n
+		return nil
+	}
+
+	var err error
+	e.daysOfWeek, err = parseCronField(s, 1, 7, weekdayNames, false)
+
+	return err
+}
+
+// parseCronField parses a comma-separated list of values, ranges and, if allowStep is true, increments.
+// The result is indexed by value.
+func parseCronField(s string, lo, hi int, names []string, allowStep bool) ([]bool, error) {
+	values := make([]bool, hi+1)
+
+	for element := range strings.SplitSeq(s, ",") {
+		r, step, hasStep := strings.Cut(element, "/")
+		increment := 1
+		if hasStep {
+			if !allowStep {
+				return nil, fmt.Errorf("increments are not supported: %q", element)
+			}
+			v, err := strconv.Atoi(step)
+			if err != nil || v < 1 {
+				return nil, fmt.Errorf("invalid increment: %q", element)
+			}
+			increment = v
+		}
+
+		start, end := lo, hi
+		switch from, to, isRange := strings.Cut(r, "-"); {
+		case r == "*":
+		case isRange:
+			var err error
+			if start, err = parseCronValue(from, lo, hi, names); err != nil {
+				return nil, err
+			}
+			if end, err = parseCronValue(to, lo, hi, names); err != nil {
+				return nil, err
+			}
+			if start > end {
+				return nil, fmt.Errorf("invalid range: %q", element)
+			}
+		default:
+			var err error
+			if start, err = parseCronValue(r, lo, hi, names); err != nil {
+				return nil, err
+			}
+			if !hasStep {
+				end = start
+			}
+		}
+
+		for v := start; v <= end; v += increment {
+			values[v] = true
+		}
+	}
+
+	return values, nil
+}
+
+func parseCronValue(s string, lo, hi int, names []string) (int, error) {
+	for i, name := range names {
+		if strings.EqualFold(s, name) {
+			return lo + i, nil
+		}
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value: %q", s)
+	}
+	if v < lo || v > hi {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, lo, hi)
+	}
+
+	return v, nil
+}
+
+func (e cronExpression) Next(t time.Time) (time.Time, bool) {
+	loc := t.Location()
+	// Cron expressions have a resolution of one minute.
+	t = t.Truncate(time.Minute)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+
+	for day.Year() <= maxYear {
+		year, month, d := day.Date()
+
+		if year < minYear || !e.years[year] {
+			day = time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !e.months[month] {
+			day = time.Date(year, month+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if e.matchesDay(year, month, d) {
+			for hour := range 24 {
+				if !e.hours[hour] {
+					continue
+				}
+				for minute := range 60 {
+					if !e.minutes[minute] {
+						continue
+					}
+
+					next := time.Date(year, month, d, hour, minute, 0, 0, loc)
+					// Skip wall clock times that do not exist because of daylight saving time transitions.
+					if next.Hour() != hour || next.Minute() != minute {
+						continue
+					}
+					if next.After(t) {
+						return next, true
+					}
+				}
+			}
+		}
+
+		day = time.Date(year, month, d+1, 0, 0, 0, 0, loc)
+	}
+
+	return time.Time{}, false
+}
+
+func (e cronExpression) matchesDay(year int, month time.Month, day int) bool {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	weekday := int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday()) + 1
+
+	switch {
+	case e.daysOfMonth != nil:
+		return e.daysOfMonth[day]
+	case e.lastDayOfMonth:
+		return day == lastDay
+	case e.lastWeekday:
+		return day == nearestWeekday(year, month, lastDay)
+	case e.nearestWeekdayTo > 0:
+		return day == nearestWeekday(year, month, min(e.nearestWeekdayTo, lastDay))
+	case e.daysOfWeek != nil:
+		return e.daysOfWeek[weekday]
+	case e.lastDayOfWeek > 0:
+		return weekday == e.lastDayOfWeek && day+7 > lastDay
+	case e.nthDayOfWeek > 0:
+		return weekday == e.nthDayOfWeek && (day-1)/7+1 == e.nthDayOfWeekOrdinal
+	}
+
+	return false
+}
+
+// nearestWeekday returns the weekday (Monday to Friday) nearest the specified day, without leaving the month.
+func nearestWeekday(year int, month time.Month, day int) int {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == lastDay {
+			return day - 2
+		}
+		return day + 1
+	}
+
+	return day
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schedule parses the AWS schedule expression dialect accepted by
+// Amazon EventBridge, EventBridge Scheduler and other services:
+//
+//   - cron(Minutes Hours Day-of-month Month Day-of-week Year)
+//   - rate(Value Unit)
+//   - at(yyyy-mm-ddThh:mm:ss)
+//
+// See https://docs.aws.amazon.com/scheduler/latest/UserGuide/schedule-types.html.
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	minYear = 1970
+	maxYear = 2199
+)
+
+// Expression is a parsed schedule expression.
+type Expression interface {
+	// Next returns the first fire time strictly after t.
+	// Wall clock times in the expression are interpreted in t's location.
+	// The second return value is false if there are no further fire times.
+	Next(t time.Time) (time.Time, bool)
+}
+
+// Parse parses a cron(), rate() or at() schedule expression.
+func Parse(s string) (Expression, error) {
+	switch {
+	case strings.HasPrefix(s, "cron(") && strings.HasSuffix(s, ")"):
+		return parseCron(strings.TrimSuffix(strings.TrimPrefix(s, "cron("), ")"))
+	case strings.HasPrefix(s, "rate(") && strings.HasSuffix(s, ")"):
+		return parseRate(strings.TrimSuffix(strings.TrimPrefix(s, "rate("), ")"))
+	case strings.HasPrefix(s, "at(") && strings.HasSuffix(s, ")"):
+		return parseAt(strings.TrimSuffix(strings.TrimPrefix(s, "at("), ")"))
+	}
+
+	return nil, fmt.Errorf("%q is not a valid schedule expression: must be one of cron(...), rate(...) or at(...)", s)
+}
+
+// NextN returns up to n fire times strictly after t.
+func NextN(e Expression, t time.Time, n int) []time.Time {
+	times := make([]time.Time, 0, n)
+
+	for range n {
+		next, ok := e.Next(t)
+		if !ok {
+			break
+		}
+		times = append(times, next)
+		t = next
+	}
+
+	return times
+}
+
+type rateExpression struct {
+	interval time.Duration
+}
+
+func parseRate(s string) (Expression, error) {
+	value, unit, ok := strings.Cut(strings.TrimSpace(s), " ")
+	if !ok {
+		return nil, fmt.Errorf("rate expression %q must be of the form rate(value unit)", s)
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 1 {
+		return nil, fmt.Errorf("rate expression value %q must be a positive integer", value)
+	}
+
+	var interval time.Duration
+	switch strings.TrimSuffix(unit, "s") {
+	case "minute":
+		interval = time.Minute
+	case "hour":
+		interval = time.Hour
+	case "day":
+		interval = 24 * time.Hour
+	default:
+		return nil, fmt.Errorf("rate expression unit %q must be one of minute, minutes, hour, hours, day or days", unit)
+	}
+
+	if plural := strings.HasSuffix(unit, "s"); v == 1 && plural {
+		return nil, fmt.Errorf("rate expression unit %q must be singular for a value of 1", unit)
+	} else if v > 1 && !plural {
+		return nil, fmt.Errorf("rate expression unit %q must be plural for a value greater than 1", unit)
+	}
+
+	return rateExpression{interval: time.Duration(v) * interval}, nil
+}
+
+// Next returns t plus the rate interval. Rate expressions have no fixed anchor,
+// so fire times are relative to the time from which they are calculated.
+func (e rateExpression) Next(t time.Time) (time.Time, bool) {
+	return t.Add(e.interval), true
+}
+
+type atExpression struct {
+	year, month, day, hour, minute, second int
+}
+
+func parseAt(s string) (Expression, error) {
+	t, err := time.Parse("2006-01-02T15:04:05", s)
+	if err != nil {
+		return nil, fmt.Errorf("at expression %q must be of the form at(yyyy-mm-ddThh:mm:ss)", s)
+	}
+
+	return atExpression{
+		year:   t.Year(),
+		month:  int(t.Month()),
+		day:    t.Day(),
+		hour:   t.Hour(),
+		minute: t.Minute(),
+		second: t.Second(),
+	}, nil
+}
+
+func (e atExpression) Next(t time.Time) (time.Time, bool) {
+	next := time.Date(e.year, time.Month(e.month), e.day, e.hour, e.minute, e.second, 0, t.Location())
+	if !next.After(t) {
+		return time.Time{}, false
+	}
+
+	return next, true
+}
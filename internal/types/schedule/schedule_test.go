@@ -0,0 +1,272 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input       string
+		expectError bool
+	}{
+		"empty": {
+			input:       "",
+			expectError: true,
+		},
+		"unknown type": {
+			input:       "every(5 minutes)",
+			expectError: true,
+		},
+
+		"rate minute": {
+			input: "rate(1 minute)",
+		},
+		"rate days": {
+			input: "rate(7 days)",
+		},
+		"rate singular with plural value": {
+			input:       "rate(5 minute)",
+			expectError: true,
+		},
+		"rate plural with singular value": {
+			input:       "rate(1 hours)",
+			expectError: true,
+		},
+		"rate zero": {
+			input:       "rate(0 minutes)",
+			expectError: true,
+		},
+		"rate unit": {
+			input:       "rate(2 weeks)",
+			expectError: true,
+		},
+
+		"at": {
+			input: "at(2026-11-20T13:00:00)",
+		},
+		"at invalid": {
+			input:       "at(2026-11-20 13:00)",
+			expectError: true,
+		},
+
+		"cron every minute": {
+			input: "cron(* * * * ? *)",
+		},
+		"cron names": {
+			input: "cron(0 18 ? JAN-MAR MON-FRI 2026)",
+		},
+		"cron increments": {
+			input: "cron(0/15 8-17/2 1/5 * ? *)",
+		},
+		"cron last day of month": {
+			input: "cron(0 0 L * ? *)",
+		},
+		"cron nearest weekday": {
+			input: "cron(0 0 15W * ? *)",
+		},
+		"cron last weekday of month": {
+			input: "cron(0 0 LW * ? *)",
+		},
+		"cron last day of week": {
+			input: "cron(0 0 ? * L *)",
+		},
+		"cron last friday": {
+			input: "cron(0 0 ? * 6L *)",
+		},
+		"cron third monday": {
+			input: "cron(0 0 ? * MON#3 *)",
+		},
+		"cron five fields": {
+			input:       "cron(0 12 * * ?)",
+			expectError: true,
+		},
+		"cron both days": {
+			input:       "cron(0 12 1 * MON *)",
+			expectError: true,
+		},
+		"cron neither day": {
+			input:       "cron(0 12 ? * ? *)",
+			expectError: true,
+		},
+		"cron minute out of range": {
+			input:       "cron(60 12 * * ? *)",
+			expectError: true,
+		},
+		"cron day of week increment": {
+			input:       "cron(0 12 ? * 1/2 *)",
+			expectError: true,
+		},
+		"cron year out of range": {
+			input:       "cron(0 12 * * ? 2200)",
+			expectError: true,
+		},
+		"cron reversed range": {
+			input:       "cron(0 17-8 * * ? *)",
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(tc.input)
+
+			if got, want := err != nil, tc.expectError; got != want {
+				t.Errorf("Parse(%q) err = %v, expectError = %t", tc.input, err, tc.expectError)
+			}
+		})
+	}
+}
+
+func TestNextN(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2026, time.March, 6, 10, 30, 0, 0, time.UTC)
+
+	testcases := map[string]struct {
+		input    string
+		start    time.Time
+		n        int
+		expected []time.Time
+	}{
+		"rate": {
+			input: "rate(2 hours)",
+			start: start,
+			n:     2,
+			expected: []time.Time{
+				time.Date(2026, time.March, 6, 12, 30, 0, 0, time.UTC),
+				time.Date(2026, time.March, 6, 14, 30, 0, 0, time.UTC),
+			},
+		},
+		"at future": {
+			input: "at(2026-03-07T09:00:00)",
+			start: start,
+			n:     3,
+			expected: []time.Time{
+				time.Date(2026, time.March, 7, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		"at past": {
+			input:    "at(2026-03-05T09:00:00)",
+			start:    start,
+			n:        3,
+			expected: []time.Time{},
+		},
+		"cron weekdays": {
+			input: "cron(0 9 ? * MON-FRI *)",
+			start: start,
+			n:     3,
+			expected: []time.Time{
+				time.Date(2026, time.March, 9, 9, 0, 0, 0, time.UTC),
+				time.Date(2026, time.March, 10, 9, 0, 0, 0, time.UTC),
+				time.Date(2026, time.March, 11, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron every 15 minutes": {
+			input: "cron(0/15 * * * ? *)",
+			start: start,
+			n:     3,
+			expected: []time.Time{
+				time.Date(2026, time.March, 6, 10, 45, 0, 0, time.UTC),
+				time.Date(2026, time.March, 6, 11, 0, 0, 0, time.UTC),
+				time.Date(2026, time.March, 6, 11, 15, 0, 0, time.UTC),
+			},
+		},
+		"cron last day of month": {
+			input: "cron(0 0 L * ? *)",
+			start: start,
+			n:     2,
+			expected: []time.Time{
+				time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.April, 30, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron nearest weekday": {
+			// March 1st 2026 is a Sunday, May 1st 2026 is a Friday.
+			input: "cron(0 0 1W * ? *)",
+			start: time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC),
+			n:     3,
+			expected: []time.Time{
+				time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron last day of week": {
+			input: "cron(0 12 ? * L *)",
+			start: start,
+			n:     3,
+			expected: []time.Time{
+				time.Date(2026, time.March, 7, 12, 0, 0, 0, time.UTC),
+				time.Date(2026, time.March, 14, 12, 0, 0, 0, time.UTC),
+				time.Date(2026, time.March, 21, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron last friday": {
+			input: "cron(0 12 ? * 6L *)",
+			start: start,
+			n:     2,
+			expected: []time.Time{
+				time.Date(2026, time.March, 27, 12, 0, 0, 0, time.UTC),
+				time.Date(2026, time.April, 24, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron second tuesday": {
+			input: "cron(0 12 ? * TUE#2 *)",
+			start: start,
+			n:     2,
+			expected: []time.Time{
+				time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC),
+				time.Date(2026, time.April, 14, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron year": {
+			input: "cron(0 0 1 JAN ? 2027-2028)",
+			start: start,
+			n:     3,
+			expected: []time.Time{
+				time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2028, time.January, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron daylight saving time": {
+			// Clocks in New York move forward from 02:00 to 03:00 on March 8th 2026.
+			input: "cron(30 2 * * ? *)",
+			start: time.Date(2026, time.March, 7, 12, 0, 0, 0, newYork),
+			n:     2,
+			expected: []time.Time{
+				time.Date(2026, time.March, 9, 2, 30, 0, 0, newYork),
+				time.Date(2026, time.March, 10, 2, 30, 0, 0, newYork),
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			e, err := Parse(tc.input)
+			if err != nil {
+				t.Fatalf("Parse(%q): %s", tc.input, err)
+			}
+
+			if diff := cmp.Diff(NextN(e, tc.start, tc.n), tc.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
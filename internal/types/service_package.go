@@ -85,13 +85,14 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
-	Identity Identity
-	Import   FrameworkImport
+	Factory    func(context.Context) (resource.ResourceWithConfigure, error)
+	TypeName   string
+	Name       string
+	Tags       unique.Handle[ServicePackageResourceTags]
+	Region     unique.Handle[ServicePackageResourceRegion]
+	AssumeRole bool // Is per-resource assume role override supported?
+	Identity   Identity
+	Import     FrameworkImport
 }
 
 type ServicePackageFrameworkListResource struct {
@@ -116,13 +117,14 @@ type ServicePackageSDKDataSource struct {
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
-	Identity Identity
-	Import   SDKv2Import
+	Factory    func() *schema.Resource
+	TypeName   string
+	Name       string
+	Tags       unique.Handle[ServicePackageResourceTags]
+	Region     unique.Handle[ServicePackageResourceRegion]
+	AssumeRole bool // Is per-resource assume role override supported?
+	Identity   Identity
+	Import     SDKv2Import
 }
 
 type ListResourceForSDK interface {